@@ -1,13 +1,21 @@
 package start
 
 import (
+	"encoding/json"
 	"os"
 	"os/exec"
+	"runtime"
+	"strconv"
+	"syscall"
 
+	"github.com/cockroachdb/errors"
 	"github.com/spf13/cobra"
+	"golang.org/x/sys/unix"
 
 	"github.com/mszostok/job-runner/internal/cli"
 	"github.com/mszostok/job-runner/pkg/cgroup"
+	"github.com/mszostok/job-runner/pkg/job"
+	"github.com/mszostok/job-runner/pkg/security"
 )
 
 // ChildOptions hold additional options for starting child process.
@@ -15,6 +23,17 @@ import (
 type ChildOptions struct {
 	Env             []string
 	CGroupProcsPath string
+	WorkingDir      string
+	Umask           string
+	UID             int
+	GID             int
+	Groups          []int
+	Isolation       string
+	Rootfs          string
+	ReadOnlyPaths   []string
+	SecurityProfile string
+	ForkedByInit    bool
+	InitStatusFD    int
 }
 
 // NewChild returns a new cobra.Command for starting child process.
@@ -22,11 +41,38 @@ func NewChild() *cobra.Command {
 	var opts ChildOptions
 
 	cmd := &cobra.Command{
-		Use:    `child --cgroup-procs-path=path [--env="key=value"] -- [COMMAND] [args...]`,
+		Use:    `child --cgroup-procs-path=path [--env="key=value"] [--working-dir=path] [--umask=mask] [--uid=id --gid=id] [--groups=id] [--isolation=type [--rootfs=path] [--read-only-path=path] [--init-status-fd=fd]] [--security-profile=json] -- [COMMAND] [args...]`,
 		Short:  "Starts a child process of running Agent daemon. This is used internally by Agent",
 		Hidden: true, // only for internal usage
 		RunE: func(c *cobra.Command, args []string) error {
-			if err := cgroup.AttachCurrentProc(opts.CGroupProcsPath); err != nil {
+			// security profile restricts only the current thread, so the command must be executed from it
+			runtime.LockOSThread()
+
+			if !opts.ForkedByInit {
+				if err := cgroup.AttachCurrentProc(opts.CGroupProcsPath); err != nil {
+					return err
+				}
+			}
+
+			// the command would run as PID 1 of the new PID namespace, which ignores signals without handler, e.g. SIGTERM
+			// sent on stop, so the wrapper stays as its init and forks itself to set up and execute the command
+			if job.Isolation(opts.Isolation).Namespaced() && !opts.ForkedByInit {
+				return runAsInit(opts.InitStatusFD)
+			}
+
+			if err := setupNamespaces(opts); err != nil {
+				return err
+			}
+
+			profile, err := decodeSecurityProfile(opts.SecurityProfile)
+			if err != nil {
+				return err
+			}
+			if err := profile.PrepareCredentialChange(); err != nil {
+				return errors.Wrap(err, "while preparing security profile")
+			}
+
+			if err := applyProcSettings(c, opts); err != nil {
 				return err
 			}
 
@@ -35,22 +81,133 @@ func NewChild() *cobra.Command {
 				return err
 			}
 
+			path, err := exec.LookPath(name)
+			if err != nil {
+				return err
+			}
+
+			if err := profile.Apply(); err != nil {
+				return errors.Wrap(err, "while applying security profile")
+			}
+
+			// Replace the current process, so the command runs with the same PID, already attached to the cgroup.
+			// As a result, signals and exit status are not mediated by the wrapper.
 			// This needs to be allowed, but we need to be aware of potential risk:
 			//   https://github.com/securego/gosec/issues/204#issuecomment-384474356
 			// #nosec G204
-			cmd := exec.Command(name, arg...)
-			cmd.Env = opts.Env
-			cmd.Stdout = os.Stdout
-			cmd.Stderr = os.Stdout
-
-			return cmd.Run()
+			return syscall.Exec(path, append([]string{name}, arg...), opts.Env)
 		},
 	}
 
 	cmd.Flags().StringSliceVarP(&opts.Env, "env", "e", []string{}, `Specifies the environment of the process. Each entry is of the form "key=value".`)
 	cmd.Flags().StringVar(&opts.CGroupProcsPath, "cgroup-procs-path", "", "Specifies the path to procs file.")
+	cmd.Flags().StringVar(&opts.WorkingDir, "working-dir", "", "Specifies the working directory of the process.")
+	cmd.Flags().StringVar(&opts.Umask, "umask", "", `Specifies the file mode creation mask of the process in octal notation, e.g. "0027".`)
+	cmd.Flags().IntVar(&opts.UID, "uid", 0, "Specifies the user ID the process runs as. Must be used together with --gid.")
+	cmd.Flags().IntVar(&opts.GID, "gid", 0, "Specifies the group ID the process runs as. Must be used together with --uid.")
+	cmd.Flags().IntSliceVar(&opts.Groups, "groups", []int{}, "Specifies the supplementary group IDs of the process.")
+	cmd.Flags().StringVar(&opts.Isolation, "isolation", "", "Specifies the isolation the process was started with. New namespaces are set up accordingly.")
+	cmd.Flags().StringVar(&opts.Rootfs, "rootfs", "", "Specifies the root filesystem of the process. It requires new namespaces.")
+	cmd.Flags().StringArrayVar(&opts.ReadOnlyPaths, "read-only-path", []string{}, "Specifies the host path which is bind-mounted read-only at the same path in the root filesystem.")
+	cmd.Flags().StringVar(&opts.SecurityProfile, "security-profile", "", "Specifies the security profile, encoded in JSON, which is applied right before the command is executed.")
+	cmd.Flags().BoolVar(&opts.ForkedByInit, forkedByInitFlag, false, "Specifies that the process was forked by the wrapper running as init of new namespaces, so it's already attached to the cgroup.")
+	cmd.Flags().IntVar(&opts.InitStatusFD, "init-status-fd", 0, "Specifies the file descriptor to which the wrapper running as init of new namespaces writes the command's wait status.")
 	// error cannot happen as flag is already declared
 	_ = cmd.MarkFlagRequired("cgroup-procs-path")
 
 	return cmd
 }
+
+// applyProcSettings applies umask, credential and working directory to the current process. Privileges are dropped
+// only after the process is attached to the cgroup, and the working directory is changed as a target user.
+func applyProcSettings(c *cobra.Command, opts ChildOptions) error {
+	if opts.Umask != "" {
+		umask, err := strconv.ParseUint(opts.Umask, 8, 32)
+		if err != nil {
+			return errors.Wrap(err, "while parsing umask")
+		}
+		syscall.Umask(int(umask))
+	}
+
+	if c.Flags().Changed("uid") || c.Flags().Changed("gid") {
+		if err := syscall.Setgroups(opts.Groups); err != nil {
+			return errors.Wrap(err, "while setting supplementary groups")
+		}
+		if err := syscall.Setgid(opts.GID); err != nil {
+			return errors.Wrap(err, "while setting group ID")
+		}
+		if err := syscall.Setuid(opts.UID); err != nil {
+			return errors.Wrap(err, "while setting user ID")
+		}
+	}
+
+	if opts.WorkingDir != "" {
+		if err := os.Chdir(opts.WorkingDir); err != nil {
+			return errors.Wrap(err, "while changing working directory")
+		}
+	}
+	return nil
+}
+
+// decodeSecurityProfile returns a given profile. If it's empty, returns profile without any restrictions.
+func decodeSecurityProfile(raw string) (security.Profile, error) {
+	var profile security.Profile
+	if raw == "" {
+		return profile, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &profile); err != nil {
+		return profile, errors.Wrap(err, "while decoding security profile")
+	}
+	return profile, nil
+}
+
+// setupNamespaces prepares namespaces the current process was started in. It mounts a private /proc, so only
+// processes from the new PID namespace are visible, and brings up the loopback interface in the new network namespace.
+// If rootfs is specified, the process root is changed to it.
+func setupNamespaces(opts ChildOptions) error {
+	isolation := job.Isolation(opts.Isolation)
+	if !isolation.Namespaced() {
+		return nil
+	}
+
+	if isolation.Network() {
+		if err := setLoopbackUp(); err != nil {
+			return errors.Wrap(err, "while bringing up loopback interface")
+		}
+	}
+
+	// mount namespace is a copy of the host one, so mounts need to be private, otherwise they are propagated to the host
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return errors.Wrap(err, "while making mounts private")
+	}
+	if opts.Rootfs != "" {
+		return pivotToRootfs(opts.Rootfs, opts.ReadOnlyPaths)
+	}
+	return mountProc("/proc")
+}
+
+func mountProc(target string) error {
+	if err := unix.Mount("proc", target, "proc", unix.MS_NOSUID|unix.MS_NODEV|unix.MS_NOEXEC, ""); err != nil {
+		return errors.Wrap(err, "while mounting /proc")
+	}
+	return nil
+}
+
+// setLoopbackUp brings up the loopback interface, which is down in a new network namespace.
+func setLoopbackUp() error {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+
+	ifr, err := unix.NewIfreq("lo")
+	if err != nil {
+		return err
+	}
+	if err := unix.IoctlIfreq(fd, unix.SIOCGIFFLAGS, ifr); err != nil {
+		return err
+	}
+	ifr.SetUint16(ifr.Uint16() | unix.IFF_UP)
+	return unix.IoctlIfreq(fd, unix.SIOCSIFFLAGS, ifr)
+}
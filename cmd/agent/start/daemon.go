@@ -3,15 +3,21 @@ package start
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"sigs.k8s.io/yaml"
 
 	"github.com/mszostok/job-runner/internal/auth"
 	"github.com/mszostok/job-runner/internal/daemon"
@@ -19,21 +25,42 @@ import (
 	pb "github.com/mszostok/job-runner/pkg/api/grpc"
 	"github.com/mszostok/job-runner/pkg/cgroup"
 	"github.com/mszostok/job-runner/pkg/file"
+	"github.com/mszostok/job-runner/pkg/image"
 	"github.com/mszostok/job-runner/pkg/job"
 	"github.com/mszostok/job-runner/pkg/job/repo"
+	"github.com/mszostok/job-runner/pkg/schedule"
+	"github.com/mszostok/job-runner/pkg/security"
+	"github.com/mszostok/job-runner/pkg/workflow"
 )
 
 const (
 	daemonCGroupPath = "LPR"
+	defaultStateDir  = "/var/lib/lpr"
+	stateDirPerm     = 0700
+	imagesDirName    = "images"
 	caFlagName       = "client-ca-cert"
 	certFlagName     = "server-cert"
 	keyFlagName      = "server-key"
+
+	// image imports are limited by default, so a single import cannot exhaust the Agent's disk
+	defaultImageMaxArchiveSize  = 1 << 30 // 1 GiB
+	defaultImageMaxUnpackedSize = 4 << 30 // 4 GiB
 )
 
 // DaemonOptions holds options for starting daemon process.
 type DaemonOptions struct {
-	GRPCAddr string
-	TLS      TLSOptions
+	GRPCAddr                string
+	StateDir                string
+	TLS                     TLSOptions
+	JobResourcesLimits      job.ResourcesLimits
+	JobsShutdownGracePeriod time.Duration
+	JobsAdmissionLimits     job.AdmissionLimits
+	JobsQueueOrdering       string
+	JobIdentityPolicyPath   string
+	JobIsolation            string
+	JobSecurityProfilesPath string
+	JobsRetention           job.RetentionPolicy
+	ImageImportLimits       image.ImportLimits
 }
 
 // TLSOptions holds mTLS related settings.
@@ -60,18 +87,66 @@ func NewDaemon() *cobra.Command {
 			}
 
 			// setup library
-			jobRepo := repo.NewInMemory()
+			jobRepo, err := newJobRepository(opts.StateDir)
+			if err != nil {
+				return err
+			}
 
 			flog, err := file.NewLogger()
 			if err != nil {
 				return err
 			}
 
-			svc, err := job.NewService(jobRepo, flog)
+			identityPolicy, err := loadIdentityPolicy(opts.JobIdentityPolicyPath)
 			if err != nil {
 				return err
 			}
 
+			securityProfiles, err := loadSecurityProfiles(opts.JobSecurityProfilesPath)
+			if err != nil {
+				return err
+			}
+
+			opts.JobsAdmissionLimits.Ordering = job.QueueOrdering(strings.ToUpper(opts.JobsQueueOrdering))
+			jobOpts := []job.ServiceOption{
+				job.WithResourcesLimits(opts.JobResourcesLimits),
+				job.WithShutdownGracePeriod(opts.JobsShutdownGracePeriod),
+				job.WithAdmissionLimits(opts.JobsAdmissionLimits),
+				job.WithIdentityPolicy(identityPolicy),
+				job.WithDefaultIsolation(job.Isolation(strings.ToUpper(opts.JobIsolation))),
+				job.WithSecurityProfiles(securityProfiles),
+			}
+			handlerOpts := []daemon.HandlerOption{}
+
+			// images are stored only on disk, so they are available only if state dir is set
+			if opts.StateDir != "" {
+				imageSvc, err := image.NewService(filepath.Join(opts.StateDir, imagesDirName), image.WithImportLimits(opts.ImageImportLimits))
+				if err != nil {
+					return err
+				}
+				jobOpts = append(jobOpts, job.WithRootfsProvider(imageSvc))
+				handlerOpts = append(handlerOpts, daemon.WithImageService(imageSvc))
+			}
+
+			svc, err := job.NewService(jobRepo, flog, jobOpts...)
+			if err != nil {
+				return err
+			}
+
+			scheduleSvc, err := schedule.NewService(svc, scheduleServiceOptions(opts.StateDir)...)
+			if err != nil {
+				return err
+			}
+			workflowSvc := workflow.NewService(svc)
+
+			var retentionCollector *job.RetentionCollector
+			if opts.JobsRetention.Enabled() {
+				retentionCollector, err = job.NewRetentionCollector(svc, opts.JobsRetention)
+				if err != nil {
+					return err
+				}
+			}
+
 			err = cgroup.BootstrapParent(daemonCGroupPath, cgroup.MemoryController, cgroup.CPUController, cgroup.IOController, cgroup.CPUSetController)
 			if err != nil {
 				return err
@@ -94,11 +169,20 @@ func NewDaemon() *cobra.Command {
 				grpc.UnaryInterceptor(auth.GRPCUnaryInterceptor),
 				grpc.StreamInterceptor(auth.GRPCStreamInterceptor),
 			)
-			pb.RegisterJobServiceServer(srv, daemon.NewHandler(svc, jobRepo))
+			handlerOpts = append(handlerOpts,
+				daemon.WithScheduleService(scheduleSvc),
+				daemon.WithWorkflowService(workflowSvc),
+			)
+			pb.RegisterJobServiceServer(srv, daemon.NewHandler(svc, jobRepo, handlerOpts...))
 
 			// setup shutdown
 			shutdownManager := &shutdown.ParentService{}
 			shutdownManager.Register(flog)
+			shutdownManager.Register(scheduleSvc)
+			shutdownManager.Register(workflowSvc)
+			if retentionCollector != nil {
+				shutdownManager.Register(retentionCollector)
+			}
 			shutdownManager.Register(svc)
 			shutdownManager.Register(shutdown.Func(srv.GracefulStop))
 
@@ -111,7 +195,11 @@ func NewDaemon() *cobra.Command {
 			scheduleParallel.Go(func() error {
 				<-parallelCtx.Done() // it's canceled on OS signals and if function passed to 'Go' method returns a non-nil error
 				log.Println("Stopping server gracefully")
-				return shutdownManager.Shutdown()
+				if err := shutdownManager.Shutdown(); err != nil {
+					return err
+				}
+				// Close the storage only when all Jobs' statuses were already persisted.
+				return jobRepo.Shutdown()
 			})
 
 			return scheduleParallel.Wait()
@@ -120,18 +208,93 @@ func NewDaemon() *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.StringVar(&opts.GRPCAddr, "grpc-addr", ":50051", "Specifies gRPC server address.")
+	flags.StringVar(&opts.StateDir, "state-dir", defaultStateDir, "Specifies directory where Jobs and ScheduledJobs are persisted, so they survive Agent restarts. If empty, they are stored only in memory, and rootfs images are disabled.")
 	flags.StringVar(&opts.TLS.Client.CAFilePath, caFlagName, "", "Path on the local disk to CA certificate to verify the client's certificate.")
 	flags.StringVar(&opts.TLS.Server.CertFilePath, certFlagName, "", "Path on the local disk to client certificate to use for auth to the client's requests.")
 	flags.StringVar(&opts.TLS.Server.KeyFilePath, keyFlagName, "", "Path on the local disk to client private key to use for auth to the client's requests.")
 
+	flags.DurationVar(&opts.JobsShutdownGracePeriod, "jobs-shutdown-grace-period", job.DefaultShutdownGracePeriod, "Specifies a period of time given to running Jobs to terminate gracefully on Agent shutdown. After that, Jobs are killed. Zero means infinite.")
+	flags.Float64Var(&opts.JobResourcesLimits.MaxCPUs, "job-max-cpus", 0, "Specifies the maximum number of CPUs that can be requested for a single Job. Zero means no limit.")
+	flags.Int64Var(&opts.JobResourcesLimits.MaxMemory, "job-max-memory", 0, "Specifies the maximum memory in bytes that can be requested for a single Job. Zero means no limit.")
+	flags.IntVar(&opts.JobsAdmissionLimits.MaxRunning, "max-running-jobs", 0, "Specifies the maximum number of concurrently running Jobs. Jobs requested above the limit are queued. Zero means no limit.")
+	flags.IntVar(&opts.JobsAdmissionLimits.MaxRunningPerTenant, "max-running-jobs-per-tenant", 0, "Specifies the maximum number of concurrently running Jobs of a single tenant. Jobs requested above the limit are queued. Zero means no limit.")
+	flags.StringVar(&opts.JobsQueueOrdering, "jobs-queue-ordering", "fifo", fmt.Sprintf("Specifies the order in which queued Jobs are started. Allowed values: %s, %s.", job.FIFOOrdering, job.PriorityOrdering))
+	flags.StringVar(&opts.JobIdentityPolicyPath, "job-identity-policy", "", "Path on the local disk to YAML file which specifies users and groups Jobs of a given tenant can run as. If empty, tenants can run Jobs as any user, including root.")
+	flags.StringVar(&opts.JobSecurityProfilesPath, "job-security-profiles", "", "Path on the local disk to YAML or JSON file which specifies named security profiles Jobs can be hardened with: kept capabilities, no_new_privs, seccomp filter and Landlock ruleset. If empty, Jobs run with the Agent's privileges.")
+	flags.StringVar(&opts.JobIsolation, "job-isolation", "host", fmt.Sprintf("Specifies Linux namespaces in which Jobs run if not requested otherwise. Allowed values: %s, %s, %s. Namespaced Jobs are started by a minimal init, which forwards signals to them and reaps orphaned processes.", job.IsolationHost, job.IsolationNamespaces, job.IsolationNamespacesWithNetwork))
+	flags.DurationVar(&opts.JobsRetention.MaxAge, "finished-jobs-max-age", 0, "Specifies how long finished Jobs are kept. Older ones are deleted together with their logs. Zero means no limit.")
+	flags.IntVar(&opts.JobsRetention.MaxCountPerTenant, "finished-jobs-max-count-per-tenant", 0, "Specifies the maximum number of finished Jobs kept for a single tenant. The oldest ones are deleted together with their logs. Zero means no limit.")
+	flags.DurationVar(&opts.JobsRetention.Interval, "finished-jobs-retention-interval", job.DefaultRetentionInterval, "Specifies how often finished Jobs are checked against their max age and max count.")
+	flags.Uint64Var(&opts.JobResourcesLimits.MaxIORate, "job-max-io-rate", 0, "Specifies the maximum IO rate that can be requested for a single Job. Zero means no limit.")
+	flags.Int64Var(&opts.ImageImportLimits.MaxArchiveSize, "image-max-archive-size", defaultImageMaxArchiveSize, "Specifies the maximum size in bytes of the archive streamed to import an image. Zero means no limit.")
+	flags.Int64Var(&opts.ImageImportLimits.MaxUnpackedSize, "image-max-unpacked-size", defaultImageMaxUnpackedSize, "Specifies the maximum total size in bytes of files unpacked from the imported image archive. Zero means no limit.")
+
 	for _, name := range []string{caFlagName, certFlagName, keyFlagName} {
 		_ = cmd.MarkFlagRequired(name)
 		_ = cmd.MarkFlagFilename(name)
 	}
+	_ = cmd.MarkFlagFilename("job-identity-policy", "yaml", "yml")
+	_ = cmd.MarkFlagFilename("job-security-profiles", "yaml", "yml", "json")
 
 	return cmd
 }
 
+func newJobRepository(stateDir string) (*repo.Repository, error) {
+	if stateDir == "" {
+		return repo.NewInMemory(), nil
+	}
+
+	if err := os.MkdirAll(stateDir, stateDirPerm); err != nil {
+		return nil, errors.Wrap(err, "while creating state directory")
+	}
+	return repo.NewOnDisk(stateDir)
+}
+
+// scheduleServiceOptions persists ScheduledJobs next to Jobs. If state dir is empty, they are stored only in memory.
+func scheduleServiceOptions(stateDir string) []schedule.ServiceOption {
+	if stateDir == "" {
+		return nil
+	}
+	return []schedule.ServiceOption{schedule.WithStateDir(stateDir)}
+}
+
+// loadIdentityPolicy loads Jobs identity policy from a given file. If path is empty, returns nil, so Jobs are not restricted.
+func loadIdentityPolicy(path string) (*job.IdentityPolicy, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	raw, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading Jobs identity policy")
+	}
+
+	var policy job.IdentityPolicy
+	if err := yaml.UnmarshalStrict(raw, &policy); err != nil {
+		return nil, errors.Wrap(err, "while parsing Jobs identity policy")
+	}
+	return &policy, nil
+}
+
+// loadSecurityProfiles loads Jobs security profiles from a given file. If path is empty, returns nil, so Jobs are not hardened.
+func loadSecurityProfiles(path string) (*security.Profiles, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	raw, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading Jobs security profiles")
+	}
+
+	// JSON is a subset of YAML, so both formats are supported
+	var profiles security.Profiles
+	if err := yaml.UnmarshalStrict(raw, &profiles); err != nil {
+		return nil, errors.Wrap(err, "while parsing Jobs security profiles")
+	}
+	return &profiles, nil
+}
+
 func getTLSConfig(opts TLSOptions) (*tls.Config, error) {
 	cert, err := tls.LoadX509KeyPair(opts.Server.CertFilePath, opts.Server.KeyFilePath)
 	if err != nil {
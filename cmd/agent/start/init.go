@@ -0,0 +1,97 @@
+package start
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/sys/unix"
+)
+
+// forkedByInitFlag specifies the flag set for the child wrapper forked by the wrapper running as init.
+const forkedByInitFlag = "forked-by-init"
+
+// runAsInit runs the current process as a minimal init of new namespaces. It forks the child wrapper, which sets up
+// the namespaces and executes the command. Received signals are forwarded to all processes in the PID namespace,
+// and orphaned processes are reaped. The init exits once the forked wrapper exits, so all remaining processes
+// are killed by kernel. As PID 1 cannot be killed by a signal sent from its namespace, the command killed by
+// a signal is reported with 128+signal number exit code, in the same way as shells do. As such exit code is ambiguous,
+// the command's wait status is also written to a given file descriptor, if it's set.
+func runAsInit(statusFD int) error {
+	var status *os.File
+	if statusFD > 0 {
+		// not inherited by the forked wrapper, so the status is read once the init exits
+		unix.CloseOnExec(statusFD)
+		status = os.NewFile(uintptr(statusFD), "init-status")
+	}
+
+	// subscribed before the wrapper is forked, so no signal is missed
+	signals := make(chan os.Signal, 32)
+	signal.Notify(signals)
+
+	self, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	// the wrapper is started as "<agent> start child [flags] -- [COMMAND] [args...]"
+	args := append([]string{"start", "child", "--" + forkedByInitFlag}, os.Args[3:]...)
+	// #nosec G204
+	cmd := exec.Command(self, args...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if _, err := unix.IoctlGetTermios(0, unix.TCGETS); err == nil {
+		// signals generated by terminal, e.g. SIGINT on Ctrl+C, are delivered only to the command, not forwarded twice
+		cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true, Foreground: true, Ctty: 0}
+	}
+	if err := cmd.Start(); err != nil {
+		return errors.Wrap(err, "while forking child wrapper")
+	}
+
+	for sig := range signals {
+		switch sig {
+		case unix.SIGCHLD:
+			if waitStatus, exited := reapChildren(cmd.Process.Pid); exited {
+				if status != nil {
+					_, _ = fmt.Fprintf(status, "%d", uint32(waitStatus))
+				}
+				os.Exit(exitCode(waitStatus))
+			}
+		case unix.SIGURG: // used by Go runtime to preempt goroutines
+		default:
+			// -1 targets all processes in the PID namespace, except the init itself
+			_ = unix.Kill(-1, sig.(syscall.Signal))
+		}
+	}
+	return nil
+}
+
+// reapChildren waits for all exited children without blocking. It returns the wait status of a given process, if it exited.
+func reapChildren(pid int) (unix.WaitStatus, bool) {
+	var (
+		out    unix.WaitStatus
+		exited bool
+	)
+	for {
+		var status unix.WaitStatus
+		reaped, err := unix.Wait4(-1, &status, unix.WNOHANG, nil)
+		if errors.Is(err, unix.EINTR) {
+			continue
+		}
+		if err != nil || reaped <= 0 {
+			return out, exited
+		}
+		if reaped == pid {
+			out, exited = status, true
+		}
+	}
+}
+
+func exitCode(status unix.WaitStatus) int {
+	if status.Signaled() {
+		return 128 + int(status.Signal())
+	}
+	return status.ExitStatus()
+}
@@ -0,0 +1,181 @@
+package start
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/mszostok/job-runner/pkg/image"
+)
+
+// rootfsDevices holds host devices which are available in the rootfs.
+var rootfsDevices = []string{"null", "zero", "full", "random", "urandom", "tty"}
+
+// rootfsDevLinks holds symbolic links created in the rootfs /dev directory.
+var rootfsDevLinks = [][2]string{
+	{"fd", "/proc/self/fd"},
+	{"stdin", "/proc/self/fd/0"},
+	{"stdout", "/proc/self/fd/1"},
+	{"stderr", "/proc/self/fd/2"},
+	{"ptmx", "pts/ptmx"},
+}
+
+// pivotToRootfs changes the root filesystem of the current process to a given one. The rootfs gets a private /proc,
+// basic devices, and host paths mounted read-only. The host root filesystem is detached afterwards.
+func pivotToRootfs(image string, readOnlyPaths []string) error {
+	rootfs, err := mountWritableLayer(image)
+	if err != nil {
+		return errors.Wrap(err, "while mounting writable layer")
+	}
+
+	proc, err := mountPoint(rootfs, "/proc", true)
+	if err != nil {
+		return err
+	}
+	if err := mountProc(proc); err != nil {
+		return err
+	}
+
+	if err := setupDev(rootfs); err != nil {
+		return errors.Wrap(err, "while setting up /dev")
+	}
+
+	for _, path := range readOnlyPaths {
+		if err := bindReadOnly(rootfs, path); err != nil {
+			return errors.Wrapf(err, "while mounting read-only path %q", path)
+		}
+	}
+
+	// pivot_root with the same new and old root stacks the old root on top of the new one, so it can be detached
+	if err := unix.Chdir(rootfs); err != nil {
+		return errors.Wrap(err, "while changing directory to rootfs")
+	}
+	if err := unix.PivotRoot(".", "."); err != nil {
+		return errors.Wrap(err, "while pivoting root")
+	}
+	if err := unix.Unmount(".", unix.MNT_DETACH); err != nil {
+		return errors.Wrap(err, "while detaching host root filesystem")
+	}
+	return unix.Chdir("/")
+}
+
+// mountWritableLayer mounts an overlay with a given image as the read-only lower layer. All changes, including
+// mount points created for the Job, are written to the upper layer in tmpfs, so the image is shared by Jobs
+// without being modified. The tmpfs is released together with the mount namespace, and its pages count towards
+// the Job's memory limit. Setuid and setgid bits are ignored, so the Job cannot gain privileges from image files.
+func mountWritableLayer(image string) (string, error) {
+	// the image is referred to via descriptor, as its path is covered by the tmpfs in the mount namespace
+	lower, err := unix.Open(image, unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return "", errors.Wrap(err, "while opening image")
+	}
+	defer unix.Close(lower)
+
+	if err := unix.Mount("tmpfs", image, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "mode=755"); err != nil {
+		return "", errors.Wrap(err, "while mounting tmpfs")
+	}
+	// overlay options are comma-separated, so the layers are referred to relatively to not depend on the image path
+	if err := unix.Chdir(image); err != nil {
+		return "", errors.Wrap(err, "while changing directory to tmpfs")
+	}
+	for _, dir := range []string{"upper", "work", "merged"} {
+		if err := os.Mkdir(dir, 0755); err != nil {
+			return "", err
+		}
+	}
+
+	opts := fmt.Sprintf("lowerdir=/proc/self/fd/%d,upperdir=upper,workdir=work", lower)
+	if err := unix.Mount("overlay", "merged", "overlay", unix.MS_NOSUID|unix.MS_NODEV, opts); err != nil {
+		return "", errors.Wrap(err, "while mounting overlay")
+	}
+	return filepath.Join(image, "merged"), nil
+}
+
+// setupDev mounts a new /dev with basic devices bind-mounted from the host, and a private devpts instance.
+func setupDev(rootfs string) error {
+	dev, err := mountPoint(rootfs, "/dev", true)
+	if err != nil {
+		return err
+	}
+	if err := unix.Mount("tmpfs", dev, "tmpfs", unix.MS_NOSUID|unix.MS_STRICTATIME, "mode=755,size=65536k"); err != nil {
+		return err
+	}
+
+	// the tmpfs is empty, so paths below cannot lead outside the rootfs
+	for _, name := range rootfsDevices {
+		target := filepath.Join(dev, name)
+		if err := os.WriteFile(target, nil, 0600); err != nil {
+			return err
+		}
+		if err := unix.Mount(filepath.Join("/dev", name), target, "", unix.MS_BIND, ""); err != nil {
+			return errors.Wrapf(err, "while mounting device %q", name)
+		}
+	}
+	for _, link := range rootfsDevLinks {
+		if err := os.Symlink(link[1], filepath.Join(dev, link[0])); err != nil {
+			return err
+		}
+	}
+
+	pts := filepath.Join(dev, "pts")
+	if err := os.Mkdir(pts, 0755); err != nil {
+		return err
+	}
+	if err := unix.Mount("devpts", pts, "devpts", unix.MS_NOSUID|unix.MS_NOEXEC, "newinstance,ptmxmode=0666,mode=0620"); err != nil {
+		return errors.Wrap(err, "while mounting devpts")
+	}
+
+	shm := filepath.Join(dev, "shm")
+	if err := os.Mkdir(shm, 0755); err != nil {
+		return err
+	}
+	return unix.Mount("shm", shm, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV|unix.MS_NOEXEC, "mode=1777,size=65536k")
+}
+
+// bindReadOnly mounts a given host path read-only at the same path in the rootfs.
+func bindReadOnly(rootfs, path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	target, err := mountPoint(rootfs, path, info.IsDir())
+	if err != nil {
+		return err
+	}
+
+	if err := unix.Mount(path, target, "", unix.MS_BIND, ""); err != nil {
+		return err
+	}
+	// read-only flag is ignored when the bind mount is created, so it needs to be remounted
+	return unix.Mount("", target, "", unix.MS_BIND|unix.MS_REMOUNT|unix.MS_RDONLY, "")
+}
+
+// mountPoint returns path in the rootfs for a given mount point. The directory or empty file is created in the writable
+// layer if it doesn't exist. Mount point cannot be a symbolic link, as it would be resolved against the host root filesystem.
+func mountPoint(rootfs, path string, dir bool) (string, error) {
+	target, err := image.SecureJoin(rootfs, path)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := os.Lstat(target)
+	switch {
+	case err == nil && info.Mode()&os.ModeSymlink != 0:
+		return "", errors.Newf("mount point %q is a symbolic link", path)
+	case err == nil:
+		return target, nil
+	case !errors.Is(err, os.ErrNotExist):
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return "", err
+	}
+	if dir {
+		return target, os.Mkdir(target, 0755)
+	}
+	return target, os.WriteFile(target, nil, 0644)
+}
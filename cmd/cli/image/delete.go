@@ -0,0 +1,40 @@
+package image
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/printer"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+// NewDelete returns a new cobra.Command for deleting image.
+func NewDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "rm NAME",
+		Aliases: []string{"delete"},
+		Short:   "Deletes a given rootfs image. Registered host directory is kept, only its registration is removed",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Printf("while cleaning up connection: %v", err)
+				}
+			}()
+
+			status := printer.NewStatus(c.OutOrStdout())
+
+			status.Step("Deleting %q", args[0])
+			_, err = client.DeleteImage(c.Context(), &grpc.DeleteImageRequest{Name: args[0]})
+			status.End(err == nil)
+			// TODO(simplification): to improve UX, gRPC errors can be translated to more user friendly messages
+			return err
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package image
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewCmd returns a new cobra.Command subcommand for rootfs images related operations.
+func NewCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:   "image",
+		Short: "This command consists of multiple subcommands managing rootfs images, which Jobs can use as their root filesystem",
+	}
+
+	root.AddCommand(
+		NewImport(),
+		NewList(),
+		NewDelete(),
+	)
+	return root
+}
@@ -0,0 +1,122 @@
+package image
+
+import (
+	"errors"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/heredoc"
+	"github.com/mszostok/job-runner/internal/cli/printer"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+// importChunkSize is the size of archive chunks sent to Agent, well below the gRPC message size limit.
+const importChunkSize = 64 * 1024
+
+// ImportOptions holds options for importing image.
+type ImportOptions struct {
+	HostPath string
+}
+
+// NewImport returns a new cobra.Command for importing image.
+func NewImport() *cobra.Command {
+	var opts ImportOptions
+
+	cmd := &cobra.Command{
+		Use:   "import NAME [FILE|-]",
+		Short: "Imports a given tar archive, or OCI image layout, as a rootfs image",
+		Args:  cobra.RangeArgs(1, 2),
+		Example: heredoc.WithCLIName(`
+			# Import the "alpine" image from a root filesystem tarball
+			<cli> image import alpine ./alpine-minirootfs.tar.gz
+
+			# Import the "alpine" image from OCI image layout, e.g. exported with "skopeo copy docker://alpine oci-archive:alpine.tar"
+			<cli> image import alpine ./alpine.tar
+
+			# Import the "alpine" image from stdin
+			docker export $(docker create alpine) | <cli> image import alpine -
+
+			# Register the "debian" image from directory on the Agent's host, only admin can do that
+			<cli> image import debian --host-path=/srv/rootfs/debian
+		`, cli.Name),
+		RunE: func(c *cobra.Command, args []string) error {
+			first := &grpc.ImportImageRequest{Name: args[0], HostPath: opts.HostPath}
+
+			var archive io.Reader
+			switch {
+			case opts.HostPath != "" && len(args) == 2:
+				return errors.New("cannot specify both archive and host path")
+			case opts.HostPath != "":
+			case len(args) == 1:
+				return errors.New("archive file is required, use '-' to read it from stdin")
+			case args[1] == "-":
+				archive = c.InOrStdin()
+			default:
+				f, err := os.Open(filepath.Clean(args[1]))
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				archive = f
+			}
+
+			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Printf("while cleaning up connection: %v", err)
+				}
+			}()
+
+			status := printer.NewStatus(c.OutOrStdout())
+
+			status.Step("Importing %q", args[0])
+			err = importImage(client, c, first, archive)
+			status.End(err == nil)
+			// TODO(simplification): to improve UX, gRPC errors can be translated to more user friendly messages
+			return err
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.HostPath, "host-path", "", "Specifies an absolute path of the directory on the Agent's host which is registered as the image instead of importing archive. The directory is used in place.")
+
+	return cmd
+}
+
+// importImage sends the first request, and then a given archive in chunks. Archive is nil if host path is registered.
+func importImage(client grpc.JobServiceClient, c *cobra.Command, first *grpc.ImportImageRequest, archive io.Reader) error {
+	stream, err := client.ImportImage(c.Context())
+	if err != nil {
+		return err
+	}
+
+	// if sending fails, the stream was aborted, and its actual error is returned by CloseAndRecv
+	if err := stream.Send(first); err == nil && archive != nil {
+		buff := make([]byte, importChunkSize)
+		for {
+			n, err := archive.Read(buff)
+			if n > 0 {
+				if err := stream.Send(&grpc.ImportImageRequest{Chunk: buff[:n]}); err != nil {
+					break
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = stream.CloseAndRecv()
+	return err
+}
@@ -0,0 +1,55 @@
+package image
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/heredoc"
+	"github.com/mszostok/job-runner/internal/cli/printer"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+// NewList returns a new cobra.Command for listing images.
+func NewList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "Lists rootfs images. Images are shared, so all tenants can use them",
+		Args:    cobra.NoArgs,
+		Example: heredoc.WithCLIName(`
+			# List all images
+			<cli> image list
+		`, cli.Name),
+		RunE: func(c *cobra.Command, args []string) error {
+			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Printf("while cleaning up connection: %v", err)
+				}
+			}()
+
+			out, err := client.ListImages(c.Context(), &grpc.ListImagesRequest{})
+			if err != nil { // TODO(simplification): to improve UX, gRPC errors can be translated to a user friendly messages
+				return err
+			}
+
+			images := make([]printer.ImageDefinition, 0, len(out.Images))
+			for _, item := range out.Images {
+				images = append(images, printer.ImageDefinition{
+					Name:      item.Name,
+					CreatedBy: item.CreatedBy,
+					CreatedAt: item.CreatedAt,
+					HostPath:  item.HostPath,
+				})
+			}
+
+			imagePrinter := printer.ImageTable{}
+			return imagePrinter.PrintList(images, c.OutOrStdout())
+		},
+	}
+}
@@ -0,0 +1,168 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/heredoc"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+// AttachOptions holds options for attaching to Job.
+type AttachOptions struct {
+	NoStdin bool
+}
+
+// NewAttach returns a new cobra.Command for attaching to running Job.
+func NewAttach() *cobra.Command {
+	var opts AttachOptions
+
+	cmd := &cobra.Command{
+		Use:   "attach NAME",
+		Short: "Attaches local stdin, stdout and stderr to a running Job",
+		Long: heredoc.Doc(`
+			Attaches local stdin, stdout and stderr to a running Job. Only output produced after attaching is printed.
+
+			If the Job has a pseudo-terminal, the local terminal is switched to raw mode, so all keys, including Ctrl+C,
+			are sent to the Job. The command exits once the Job finishes.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.WithCLIName(`
+			# Attach to the "episode-42" Job started with "<cli> job run -it episode-42 -- bash"
+			<cli> job attach episode-42
+
+			# Only print the output of the "episode-42" Job
+			<cli> job attach episode-42 --no-stdin
+		`, cli.Name),
+		RunE: func(c *cobra.Command, args []string) error {
+			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Printf("while cleaning up connection: %v", err)
+				}
+			}()
+
+			return attach(c.Context(), client, args[0], !opts.NoStdin, c.OutOrStdout(), c.ErrOrStderr())
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.NoStdin, "no-stdin", false, "Doesn't forward local stdin to the Job.")
+
+	return cmd
+}
+
+// attach streams the Job's output until it finishes. If requested, local stdin is forwarded to the Job.
+// For Jobs with pseudo-terminal, the local terminal is switched to raw mode and its size changes are forwarded.
+func attach(ctx context.Context, client grpc.JobServiceClient, name string, forwardStdin bool, stdout, stderr io.Writer) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.Attach(ctx)
+	if err != nil {
+		return err
+	}
+	sender := &attachSender{stream: stream}
+
+	if err := sender.Send(&grpc.AttachRequest{Name: name}); err != nil {
+		return err
+	}
+	first, err := stream.Recv()
+	if err != nil { // TODO(simplification): to improve UX, gRPC errors can be translated to a user friendly messages
+		return err
+	}
+
+	stdinFd := int(os.Stdin.Fd())
+	if first.Tty && term.IsTerminal(stdinFd) {
+		state, err := term.MakeRaw(stdinFd)
+		if err != nil {
+			return fmt.Errorf("while switching terminal to raw mode: %w", err)
+		}
+		defer func() {
+			_ = term.Restore(stdinFd, state)
+		}()
+		go forwardTerminalSize(ctx, stdinFd, sender)
+	}
+
+	if forwardStdin {
+		go forwardLocalStdin(os.Stdin, sender)
+	}
+
+	for {
+		resp, err := stream.Recv() // it's blocking operation, but it will be released, when stream will be closed/canceled
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		w := stdout
+		if resp.Stream == grpc.LogStream_STDERR {
+			w = stderr
+		}
+		_, _ = w.Write(resp.Output)
+	}
+}
+
+// attachSender allows sending requests from multiple goroutines.
+type attachSender struct {
+	mu     sync.Mutex
+	stream grpc.JobService_AttachClient
+}
+
+func (s *attachSender) Send(req *grpc.AttachRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stream.Send(req)
+}
+
+// forwardLocalStdin sends local stdin to the Job. Once the local stdin reaches EOF, the Job's stdin is closed.
+func forwardLocalStdin(stdin io.Reader, sender *attachSender) {
+	buff := make([]byte, 32*1024)
+	for {
+		n, err := stdin.Read(buff)
+		if n > 0 {
+			if sendErr := sender.Send(&grpc.AttachRequest{Stdin: buff[:n]}); sendErr != nil {
+				return
+			}
+		}
+		if err != nil {
+			if err == io.EOF {
+				_ = sender.Send(&grpc.AttachRequest{CloseStdin: true})
+			}
+			return
+		}
+	}
+}
+
+// forwardTerminalSize sends the current terminal size, and then each time it's changed.
+func forwardTerminalSize(ctx context.Context, fd int, sender *attachSender) {
+	resized := make(chan os.Signal, 1)
+	signal.Notify(resized, syscall.SIGWINCH)
+	defer signal.Stop(resized)
+
+	for {
+		if cols, rows, err := term.GetSize(fd); err == nil {
+			_ = sender.Send(&grpc.AttachRequest{Resize: &grpc.TerminalSize{Rows: uint32(rows), Cols: uint32(cols)}})
+		}
+
+		select {
+		case <-resized:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package job
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/heredoc"
+	"github.com/mszostok/job-runner/internal/cli/printer"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+// NewDelete returns a new cobra.Command for deleting finished Job.
+func NewDelete() *cobra.Command {
+	var force bool
+	cmd := &cobra.Command{
+		Use:     "rm NAME",
+		Aliases: []string{"delete"},
+		Short:   "Deletes a given finished Job together with its logs",
+		Long:    "Deletes a given finished Job together with its logs, so its name can be reused. Running Job needs to be stopped first.",
+		Args:    cobra.ExactArgs(1),
+		Example: heredoc.WithCLIName(`
+			# Delete the Job "episode-42"
+			<cli> job rm episode-42
+
+			# Stop the Job "episode-42" and delete it
+			<cli> job stop episode-42 && <cli> job rm episode-42
+
+			# Delete the lost Job "episode-42" and kill its processes that are still running
+			<cli> job rm episode-42 --force
+		`, cli.Name),
+		RunE: func(c *cobra.Command, args []string) error {
+			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Printf("while cleaning up connection: %v", err)
+				}
+			}()
+
+			status := printer.NewStatus(c.OutOrStdout())
+
+			status.Step("Deleting %q", args[0])
+			_, err = client.Delete(c.Context(), &grpc.DeleteRequest{Name: args[0], Force: force})
+			status.End(err == nil)
+			// TODO(simplification): to improve UX, gRPC errors can be translated to more user friendly messages
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Deletes also the Job lost on Agent restart whose processes are still running. Such processes are killed.")
+	return cmd
+}
@@ -1,8 +1,11 @@
 package job
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -12,15 +15,25 @@ import (
 	"github.com/mszostok/job-runner/pkg/api/grpc"
 )
 
-// NewGet returns a new cobra.Command for fetching a given Job.
-// TODO(UX): If NAME not provided, list all Jobs, same as `kubectl get foo` does.
+// GetOptions holds options for listing Jobs. They are ignored if the Job NAME is specified.
+type GetOptions struct {
+	Statuses   []string
+	Tenant     string
+	NamePrefix string
+	ChunkSize  int32
+}
+
+// NewGet returns a new cobra.Command for fetching a given Job, or listing all Jobs if NAME is not specified.
 func NewGet() *cobra.Command {
-	jobPrinter := printer.NewForJob(os.Stdout)
+	var (
+		opts       GetOptions
+		jobPrinter = printer.NewForJob(os.Stdout)
+	)
 
 	cmd := &cobra.Command{
-		Use:   "get NAME",
-		Short: "Returns a given Job definition",
-		Args:  cobra.ExactArgs(1),
+		Use:   "get [NAME | ID]",
+		Short: "Returns a given Job definition or lists all Jobs",
+		Args:  cobra.MaximumNArgs(1),
 		Example: heredoc.WithCLIName(`
 			# Show the Job "episode-42" in table format
 			<cli> job get episode-42
@@ -30,6 +43,18 @@ func NewGet() *cobra.Command {
 
 			# Show the Job "episode-42" in JSON format
 			<cli> job get episode-42 -ojson
+
+			# Show the Job with a given ID
+			<cli> job get 4f1c2a9be07d53a6c8e1
+
+			# Show the Job "episode-42" of the "Ricky" tenant, only admin may access other tenants' Jobs
+			<cli> job get Ricky/episode-42
+
+			# List all Jobs
+			<cli> job get
+
+			# List all running Jobs which name starts with "episode-"
+			<cli> job get --status=RUNNING --name-prefix=episode-
 		`, cli.Name),
 		RunE: func(c *cobra.Command, args []string) error {
 			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
@@ -42,6 +67,14 @@ func NewGet() *cobra.Command {
 				}
 			}()
 
+			if len(args) == 0 {
+				jobs, err := listJobs(c.Context(), client, opts)
+				if err != nil {
+					return err
+				}
+				return jobPrinter.PrintList(jobs)
+			}
+
 			input := grpc.GetRequest{
 				Name: args[0],
 			}
@@ -51,15 +84,114 @@ func NewGet() *cobra.Command {
 			}
 
 			return jobPrinter.Print(printer.JobDefinition{
-				Name:      input.Name,
-				CreatedBy: out.CreatedBy,
-				Status:    out.Status.String(),
-				ExitCode:  int(out.ExitCode),
+				ID:                out.Id,
+				Name:              out.Name,
+				CreatedBy:         out.CreatedBy,
+				PID:               int(out.Pid),
+				Status:            out.Status.String(),
+				ExitCode:          int(out.ExitCode),
+				CreatedAt:         out.CreatedAt,
+				StartedAt:         out.StartedAt,
+				FinishedAt:        out.FinishedAt,
+				TerminationReason: terminationReasonString(out.TerminationReason),
+				Signal:            out.Signal,
+				StoppedBy:         out.StoppedBy,
+				Duration:          printer.JobDuration(out.StartedAt, out.FinishedAt),
+				Restarts:          int(out.Restarts),
+				Attempts:          mapAttempts(out.Restarts, out.Attempts),
+				QueuePosition:     int(out.QueuePosition),
+				SecurityProfile:   out.SecurityProfile,
+				Error:             out.Error,
 			})
 		},
 	}
 
-	jobPrinter.RegisterFlags(cmd.Flags())
+	flags := cmd.Flags()
+	flags.StringSliceVar(&opts.Statuses, "status", []string{}, fmt.Sprintf("Lists only Jobs in given statuses. Allowed values: %s.", availableStatuses()))
+	flags.StringVar(&opts.Tenant, "tenant", "", "Lists only Jobs created by a given tenant. Only admin may list Jobs of other tenants.")
+	flags.StringVar(&opts.NamePrefix, "name-prefix", "", "Lists only Jobs which name starts with a given prefix.")
+	flags.Int32Var(&opts.ChunkSize, "chunk-size", 100, "Returns large lists in chunks rather than all at once.")
+	jobPrinter.RegisterFlags(flags)
 
 	return cmd
 }
+
+func listJobs(ctx context.Context, client grpc.JobServiceClient, opts GetOptions) ([]printer.JobDefinition, error) {
+	req := grpc.ListRequest{
+		Tenant:     opts.Tenant,
+		NamePrefix: opts.NamePrefix,
+		PageSize:   opts.ChunkSize,
+	}
+	for _, raw := range opts.Statuses {
+		status, found := grpc.Status_value[strings.ToUpper(raw)]
+		if !found {
+			return nil, fmt.Errorf("unknown status %q, allowed values: %s", raw, availableStatuses())
+		}
+		req.Statuses = append(req.Statuses, grpc.Status(status))
+	}
+
+	var out []printer.JobDefinition
+	for {
+		resp, err := client.List(ctx, &req)
+		if err != nil { // TODO(simplification): to improve UX, gRPC errors can be translated to a user friendly messages
+			return nil, err
+		}
+
+		for _, job := range resp.Jobs {
+			out = append(out, printer.JobDefinition{
+				ID:                job.Id,
+				Name:              job.Name,
+				CreatedBy:         job.CreatedBy,
+				Status:            job.Status.String(),
+				ExitCode:          int(job.ExitCode),
+				CreatedAt:         job.CreatedAt,
+				StartedAt:         job.StartedAt,
+				FinishedAt:        job.FinishedAt,
+				TerminationReason: terminationReasonString(job.TerminationReason),
+				Signal:            job.Signal,
+				StoppedBy:         job.StoppedBy,
+				Duration:          printer.JobDuration(job.StartedAt, job.FinishedAt),
+				Restarts:          int(job.Restarts),
+				QueuePosition:     int(job.QueuePosition),
+			})
+		}
+
+		if resp.NextPageToken == "" {
+			return out, nil
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+func availableStatuses() string {
+	out := make([]string, 0, len(grpc.Status_name))
+	for i := 0; i < len(grpc.Status_name); i++ {
+		out = append(out, grpc.Status_name[int32(i)])
+	}
+	return strings.Join(out, ", ")
+}
+
+// mapAttempts returns nil if Job was never restarted, so attempts are omitted in the output.
+func mapAttempts(restarts int32, in []*grpc.Attempt) []printer.JobAttempt {
+	if restarts == 0 {
+		return nil
+	}
+	out := make([]printer.JobAttempt, 0, len(in))
+	for _, attempt := range in {
+		out = append(out, printer.JobAttempt{
+			StartedAt:  attempt.StartedAt,
+			FinishedAt: attempt.FinishedAt,
+			ExitCode:   int(attempt.ExitCode),
+			Signal:     attempt.Signal,
+		})
+	}
+	return out
+}
+
+// terminationReasonString returns empty string if Job was not terminated, so it's omitted in the output.
+func terminationReasonString(in grpc.TerminationReason) string {
+	if in == grpc.TerminationReason_NONE {
+		return ""
+	}
+	return in.String()
+}
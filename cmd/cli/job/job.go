@@ -15,7 +15,11 @@ func NewCmd() *cobra.Command {
 		NewRun(),
 		NewGet(),
 		NewLogs(),
+		NewAttach(),
 		NewStop(),
+		NewKill(),
+		NewDelete(),
+		NewTop(),
 	)
 	return root
 }
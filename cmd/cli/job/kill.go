@@ -0,0 +1,64 @@
+package job
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/heredoc"
+	"github.com/mszostok/job-runner/internal/cli/printer"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+type KillOptions struct {
+	Name   string
+	Signal string
+}
+
+// NewKill returns a new cobra.Command for sending a signal to a running Job.
+func NewKill() *cobra.Command {
+	var opts KillOptions
+
+	cmd := &cobra.Command{
+		Use:   "kill NAME",
+		Short: "Sends a signal to all processes of a running Job",
+		Long:  "Sends a signal to all processes of a running Job. Unlike stop, it doesn't wait until the Job exits.",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.WithCLIName(`
+			# Send SIGTERM to the Job "episode-42"
+			<cli> job kill episode-42
+
+			# Ask the Job "episode-42" to reload its configuration
+			<cli> job kill episode-42 -s HUP
+		`, cli.Name),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Name = args[0]
+
+			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Printf("while cleaning up connection: %v", err)
+				}
+			}()
+
+			status := printer.NewStatus(c.OutOrStdout())
+
+			status.Step("Sending %s to %q", opts.Signal, opts.Name)
+			_, err = client.Signal(c.Context(), &grpc.SignalRequest{
+				Name:   opts.Name,
+				Signal: opts.Signal,
+			})
+			status.End(err == nil)
+			// TODO(simplification): to improve UX, gRPC errors can be translated to more user friendly messages
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Signal, "signal", "s", "SIGTERM", "Signal to send, e.g. SIGHUP, HUP or 1.")
+
+	return cmd
+}
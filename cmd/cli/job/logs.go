@@ -6,15 +6,49 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/heredoc"
 	"github.com/mszostok/job-runner/pkg/api/grpc"
 )
 
+// LogsOptions holds options for fetching Job's logs.
+type LogsOptions struct {
+	Stdout     bool
+	Stderr     bool
+	Follow     bool
+	Tail       int64
+	LimitBytes int64
+}
+
+// Streams returns requested log streams. If none is selected, both stdout and stderr are requested.
+func (o LogsOptions) Streams() []grpc.LogStream {
+	var out []grpc.LogStream
+	if o.Stdout {
+		out = append(out, grpc.LogStream_STDOUT)
+	}
+	if o.Stderr {
+		out = append(out, grpc.LogStream_STDERR)
+	}
+	return out
+}
+
 // NewLogs returns a new cobra.Command for fetching Job's related logs.
 func NewLogs() *cobra.Command {
+	var opts LogsOptions
+
 	cmd := &cobra.Command{
 		Use:   "logs NAME",
 		Short: "Prints the logs for a Job",
 		Args:  cobra.ExactArgs(1),
+		Example: heredoc.WithCLIName(`
+			# Print both stdout and stderr of the "episode-42" Job
+			<cli> job logs episode-42
+
+			# Print only stderr of the "episode-42" Job
+			<cli> job logs episode-42 --stderr
+
+			# Print the last 100 lines of the "episode-42" Job and stream new logs until the Job finishes
+			<cli> job logs episode-42 -f --tail=100
+		`, cli.Name),
 		RunE: func(c *cobra.Command, args []string) error {
 			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
 			if err != nil {
@@ -27,15 +61,26 @@ func NewLogs() *cobra.Command {
 			}()
 
 			out, err := client.StreamLogs(c.Context(), &grpc.StreamLogsRequest{
-				Name: args[0],
+				Name:       args[0],
+				Streams:    opts.Streams(),
+				Follow:     opts.Follow,
+				TailLines:  opts.Tail,
+				LimitBytes: opts.LimitBytes,
 			})
 			if err != nil { // TODO(simplification): to improve UX, gRPC errors can be translated to a user friendly messages
 				return err
 			}
 
-			return grpc.ForwardStreamLogs(c.OutOrStdout(), out)
+			return grpc.ForwardStreamLogs(c.OutOrStdout(), c.ErrOrStderr(), out)
 		},
 	}
 
+	flags := cmd.Flags()
+	flags.BoolVar(&opts.Stdout, "stdout", false, "Prints only the stdout stream. Can be combined with --stderr.")
+	flags.BoolVar(&opts.Stderr, "stderr", false, "Prints only the stderr stream. Can be combined with --stdout.")
+	flags.BoolVarP(&opts.Follow, "follow", "f", false, "Streams new logs until the Job finishes.")
+	flags.Int64Var(&opts.Tail, "tail", 0, "Prints only a given number of the most recent lines. If not specified, all lines are printed.")
+	flags.Int64Var(&opts.LimitBytes, "limit-bytes", 0, "Prints at most a given number of bytes. If not specified, there is no limit.")
+
 	return cmd
 }
@@ -1,34 +1,104 @@
 package job
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/mszostok/job-runner/internal/cli"
 	"github.com/mszostok/job-runner/internal/cli/heredoc"
 	"github.com/mszostok/job-runner/internal/cli/printer"
 	"github.com/mszostok/job-runner/pkg/api/grpc"
+	"github.com/mszostok/job-runner/pkg/cgroup"
 )
 
+// RunOptions holds Job's specification options, except command and its args.
 type RunOptions struct {
-	Env []string
+	Env                []string
+	Resources          ResourcesOptions
+	Timeout            time.Duration
+	TimeoutGracePeriod time.Duration
+	Restart            RestartOptions
+	Priority           int32
+	WorkingDir         string
+	User               string
+	Group              string
+	GroupAdd           []string
+	Umask              string
+	Isolation          string
+	Rootfs             string
+	ReadOnlyPaths      []string
+	SecurityProfile    string
+	Stdin              bool
+	TTY                bool
+}
+
+// RestartOptions holds Job's restart policy.
+type RestartOptions struct {
+	Policy      string
+	MaxRestarts int32
+	Backoff     time.Duration
+}
+
+// ResourcesOptions holds Job's system resources limits.
+type ResourcesOptions struct {
+	CPUs       float64
+	CPUSetCPUs string
+	CPUSetMems string
+	MemoryMin  int64
+	MemoryMax  int64
+	IOMax      []string
 }
 
 // NewRun returns a new cobra.Command for running Job.
 func NewRun() *cobra.Command {
-	var opts RunOptions
+	var (
+		opts         RunOptions
+		generateName string
+	)
 
 	cmd := &cobra.Command{
-		Use:   `run NAME [--env="key=value"] -- [COMMAND] [args...]`,
+		Use:   `run [NAME | --generate-name=PREFIX] [--env="key=value"] -- [COMMAND] [args...]`,
 		Short: "Runs a given Job",
-		Args:  cobra.MinimumNArgs(2),
+		Args:  cobra.MinimumNArgs(1),
 		Example: heredoc.WithCLIName(`
 			# Start the "episode-42" Job which prints "test"
 			<cli> job run episode-42 --  sh -c 'echo test'
 
+			# Start a Job named "episode-" with random suffix, e.g. "episode-x7k2p"
+			<cli> job run --generate-name=episode- -- sh -c 'echo test'
+
 			# Start the "episode-42" Job using command and custom arguments
 			<cli> job run episode-42 -- <cmd> <arg1> ... <argN>
+
+			# Start the "episode-42" Job with 2 CPUs, 4GB of memory and 10MB/s write limit on device 8:0
+			<cli> job run episode-42 --cpus=2 --memory-max=4294967296 --io-max="8:0 wbps=10485760" -- make build
+
+			# Start the "episode-42" Job which is stopped if it runs longer than 2 hours
+			<cli> job run episode-42 --timeout=2h --timeout-grace-period=30s -- make e2e-test
+
+			# Start the "episode-42" Job which is restarted up to 5 times if it fails
+			<cli> job run episode-42 --restart=on_failure --max-restarts=5 --restart-backoff=2s -- ./flaky-test.sh
+
+			# Start the "episode-42" Job with interactive shell and attach to it
+			<cli> job run -it episode-42 -- bash
+
+			# Start the "episode-42" Job in new namespaces without access to the host network
+			<cli> job run episode-42 --isolation=namespaces_with_network -- ps aux
+
+			# Start the "episode-42" Job in the "alpine" rootfs image with the host's DNS configuration
+			<cli> job run episode-42 --isolation=namespaces --rootfs=alpine --read-only-path=/etc/resolv.conf -- apk info
+
+			# Start the "episode-42" Job hardened with the "restricted" security profile
+			<cli> job run episode-42 --security-profile=restricted -- ./untrusted-tool
+
+			# Start the "episode-42" Job as the "builder" user in the "/srv/app" directory
+			<cli> job run episode-42 --user=builder --group-add=docker --workdir=/srv/app --umask=0027 -- make build
 		`, cli.Name),
 		RunE: func(c *cobra.Command, args []string) error {
 			runCmd, runArgs, err := cli.ExtractExecCommandAfterDash(c, args)
@@ -36,6 +106,26 @@ func NewRun() *cobra.Command {
 				return err
 			}
 
+			name, err := runName(args[:c.ArgsLenAtDash()], generateName)
+			if err != nil {
+				return err
+			}
+
+			resources, err := opts.Resources.ToGRPC(c.Flags())
+			if err != nil {
+				return err
+			}
+
+			restart, err := opts.Restart.ToGRPC()
+			if err != nil {
+				return err
+			}
+
+			isolation, err := IsolationToGRPC(opts.Isolation)
+			if err != nil {
+				return err
+			}
+
 			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
 			if err != nil {
 				return err
@@ -49,19 +139,208 @@ func NewRun() *cobra.Command {
 			status := printer.NewStatus(c.OutOrStdout())
 
 			status.Step("Scheduling Job")
-			_, err = client.Run(c.Context(), &grpc.RunRequest{
-				Name:    args[0],
-				Command: runCmd,
-				Args:    runArgs,
-				Env:     opts.Env,
-			})
+			req := &grpc.RunRequest{
+				Name:                name,
+				GenerateName:        generateName,
+				Command:             runCmd,
+				Args:                runArgs,
+				Env:                 opts.Env,
+				Resources:           resources,
+				Restart:             restart,
+				Priority:            opts.Priority,
+				WorkingDir:          opts.WorkingDir,
+				User:                opts.User,
+				Group:               opts.Group,
+				SupplementaryGroups: opts.GroupAdd,
+				Umask:               opts.Umask,
+				Stdin:               opts.Stdin,
+				Tty:                 opts.TTY,
+				Isolation:           isolation,
+				Rootfs:              opts.Rootfs,
+				ReadOnlyPaths:       opts.ReadOnlyPaths,
+				SecurityProfile:     opts.SecurityProfile,
+			}
+			if opts.Timeout != 0 {
+				req.Timeout = &opts.Timeout
+				req.TimeoutGracePeriod = &opts.TimeoutGracePeriod
+			}
+			out, err := client.Run(c.Context(), req)
 			status.End(err == nil)
 			// TODO(simplification): to improve UX, gRPC errors can be translated to more user friendly messages
-			return err
+			if err != nil {
+				return err
+			}
+			if !(opts.Stdin || opts.TTY) {
+				fmt.Fprintf(c.OutOrStdout(), "Job %q created with ID %s\n", out.Name, out.Id)
+				return nil
+			}
+
+			if opts.TTY {
+				// output produced before attaching is available only in logs
+				fmt.Fprintln(c.ErrOrStderr(), "If you don't see a command prompt, try pressing enter.")
+			}
+			return attach(c.Context(), client, out.Id, opts.Stdin, c.OutOrStdout(), c.ErrOrStderr())
 		},
 	}
 
-	cmd.Flags().StringSliceVarP(&opts.Env, "env", "e", []string{}, `Specifies the environment of the process. Each entry is of the form "key=value".`)
+	flags := cmd.Flags()
+	opts.RegisterFlags(flags)
+	flags.StringVar(&generateName, "generate-name", "", "Specifies the Job name prefix. Random suffix is appended to it, so the name is unique. Exclusive with NAME.")
+	flags.BoolVarP(&opts.Stdin, "stdin", "i", false, "Keeps the Job's stdin open and attaches local stdin to it.")
+	flags.BoolVarP(&opts.TTY, "tty", "t", false, "Allocates a pseudo-terminal for the Job and attaches to it. Usually used together with --stdin.")
 
 	return cmd
 }
+
+// RegisterFlags registers flags for all Job's specification options.
+func (o *RunOptions) RegisterFlags(flags *pflag.FlagSet) {
+	flags.StringSliceVarP(&o.Env, "env", "e", []string{}, `Specifies the environment of the process. Each entry is of the form "key=value".`)
+	flags.Float64Var(&o.Resources.CPUs, cpusFlagName, 0, "Specifies how many CPUs the Job can use, e.g. 1.5.")
+	flags.StringVar(&o.Resources.CPUSetCPUs, "cpuset-cpus", "", `Specifies CPUs on which the Job is allowed to run, e.g. "0-3,6".`)
+	flags.StringVar(&o.Resources.CPUSetMems, "cpuset-mems", "", `Specifies memory nodes which the Job is allowed to use, e.g. "0".`)
+	flags.Int64Var(&o.Resources.MemoryMin, "memory-min", 0, "Specifies the hard memory protection in bytes.")
+	flags.Int64Var(&o.Resources.MemoryMax, "memory-max", 0, "Specifies the memory usage hard limit in bytes.")
+	flags.DurationVar(&o.Timeout, "timeout", 0, "Specifies the maximum time the Job can run. Once exceeded, the Job is stopped with SIGTERM. Zero means no timeout.")
+	flags.DurationVar(&o.TimeoutGracePeriod, "timeout-grace-period", 10*time.Second, "Represents a period of time given to the Job to terminate gracefully once timeout is exceeded. Zero means infinite.")
+	flags.StringVar(&o.Restart.Policy, "restart", "never", fmt.Sprintf("Specifies when the Job is restarted once its process exited. Allowed values: %s.", availableRestartPolicies()))
+	flags.Int32Var(&o.Restart.MaxRestarts, "max-restarts", 0, "Specifies the maximum number of Job restarts. Zero means no limit.")
+	flags.DurationVar(&o.Restart.Backoff, "restart-backoff", 0, "Specifies the delay before the first restart. It's doubled after each restart. Zero means Agent's default.")
+	flags.Int32Var(&o.Priority, "priority", 0, "Specifies the Job's priority. If Agent is configured with priority ordering, queued Jobs with higher priority are started first.")
+	flags.StringVarP(&o.WorkingDir, "workdir", "w", "", "Specifies the absolute path of the Job's working directory. If empty, the Agent's working directory is used.")
+	flags.StringVarP(&o.User, "user", "u", "", "Specifies the user, name or UID, the Job runs as. If empty, Agent's default is used.")
+	flags.StringVar(&o.Group, "group", "", "Specifies the primary group, name or GID, the Job runs as. If empty, the user's primary group is used.")
+	flags.StringSliceVar(&o.GroupAdd, "group-add", []string{}, "Specifies additional groups, names or GIDs, of the Job.")
+	flags.StringVar(&o.Umask, "umask", "", `Specifies the Job's file mode creation mask in octal notation, e.g. "0027". If empty, the Agent's umask is inherited.`)
+	flags.StringVar(&o.Isolation, "isolation", "", fmt.Sprintf("Specifies Linux namespaces in which the Job runs. Allowed values: %s. If empty, Agent's default is used.", availableIsolations()))
+	flags.StringVar(&o.Rootfs, "rootfs", "", "Specifies the image, imported on Agent, which is used as the Job's root filesystem. Requires namespaces isolation. The Job's changes are discarded once it exits. User and groups must be specified by IDs.")
+	flags.StringSliceVar(&o.ReadOnlyPaths, "read-only-path", []string{}, "Specifies absolute paths on the Agent's host which are mounted read-only at the same paths in the Job's rootfs.")
+	flags.StringVar(&o.SecurityProfile, "security-profile", "", "Specifies the security profile, configured on Agent, the Job is hardened with. If empty, Agent's default is used.")
+	flags.StringSliceVar(&o.Resources.IOMax, "io-max", []string{}, `Specifies IO limits. Each entry is of the form "$MAJ:$MIN $TYPE=$RATE", where type is one of: rbps, wbps, riops, wiops.`)
+}
+
+const cpusFlagName = "cpus"
+
+// runName returns the Job name specified before dash (--). It's empty if the name is generated.
+func runName(args []string, generateName string) (string, error) {
+	switch {
+	case len(args) > 1:
+		return "", fmt.Errorf("expected at most one Job name, got %d", len(args))
+	case len(args) == 1 && generateName != "":
+		return "", errors.New("Job name and --generate-name flag are mutually exclusive")
+	case len(args) == 0 && generateName == "":
+		return "", errors.New("Job name or --generate-name flag is required")
+	}
+	if len(args) == 0 {
+		return "", nil
+	}
+	return args[0], nil
+}
+
+// ToGRPC returns restart options in gRPC format. Returns nil if Job should never be restarted.
+func (o RestartOptions) ToGRPC() (*grpc.RestartOptions, error) {
+	policy, found := grpc.RestartPolicy_value[strings.ToUpper(o.Policy)]
+	if !found {
+		return nil, fmt.Errorf("unknown restart policy %q, allowed values: %s", o.Policy, availableRestartPolicies())
+	}
+	if grpc.RestartPolicy(policy) == grpc.RestartPolicy_NEVER {
+		return nil, nil
+	}
+
+	out := &grpc.RestartOptions{
+		Policy:      grpc.RestartPolicy(policy),
+		MaxRestarts: o.MaxRestarts,
+	}
+	if o.Backoff != 0 {
+		out.Backoff = &o.Backoff
+	}
+	return out, nil
+}
+
+func availableRestartPolicies() string {
+	out := make([]string, 0, len(grpc.RestartPolicy_name))
+	for i := 0; i < len(grpc.RestartPolicy_name); i++ {
+		out = append(out, grpc.RestartPolicy_name[int32(i)])
+	}
+	return strings.Join(out, ", ")
+}
+
+// IsolationToGRPC returns isolation in gRPC format. Empty value means Agent's default.
+func IsolationToGRPC(in string) (grpc.Isolation, error) {
+	if in == "" {
+		return grpc.Isolation_DEFAULT_ISOLATION, nil
+	}
+	isolation, found := grpc.Isolation_value[strings.ToUpper(in)]
+	if !found || grpc.Isolation(isolation) == grpc.Isolation_DEFAULT_ISOLATION {
+		return 0, fmt.Errorf("unknown isolation %q, allowed values: %s", in, availableIsolations())
+	}
+	return grpc.Isolation(isolation), nil
+}
+
+func availableIsolations() string {
+	out := make([]string, 0, len(grpc.Isolation_name))
+	for i := 1; i < len(grpc.Isolation_name); i++ { // skip DEFAULT_ISOLATION, it's represented by empty value
+		out = append(out, grpc.Isolation_name[int32(i)])
+	}
+	return strings.Join(out, ", ")
+}
+
+var ioTypes = map[cgroup.IOType]grpc.IOType{
+	cgroup.ReadBPS:   grpc.IOType_RBPS,
+	cgroup.WriteBPS:  grpc.IOType_WBPS,
+	cgroup.ReadIOPS:  grpc.IOType_RIOPS,
+	cgroup.WriteIOPS: grpc.IOType_WIOPS,
+}
+
+// ToGRPC returns resources in gRPC format. Returns nil if no resources' limits were specified.
+func (o ResourcesOptions) ToGRPC(flags *pflag.FlagSet) (*grpc.Resources, error) {
+	return o.ToGRPCWithCPUs(flags.Changed(cpusFlagName))
+}
+
+// ToGRPCWithCPUs is similar to ToGRPC, but it doesn't depend on flags. CPU bandwidth is limited only if cpusSet is true.
+func (o ResourcesOptions) ToGRPCWithCPUs(cpusSet bool) (*grpc.Resources, error) {
+	var (
+		out   grpc.Resources
+		isSet bool
+	)
+
+	if cpusSet || o.CPUSetCPUs != "" || o.CPUSetMems != "" {
+		out.Cpu = &grpc.CPUResources{
+			Cpus: o.CPUSetCPUs,
+			Mems: o.CPUSetMems,
+		}
+		if cpusSet {
+			out.Cpu.Max = cgroup.NewCPUMax(o.CPUs)
+		}
+		isSet = true
+	}
+
+	if o.MemoryMin != 0 || o.MemoryMax != 0 {
+		out.Memory = &grpc.MemoryResources{
+			Min: o.MemoryMin,
+			Max: o.MemoryMax,
+		}
+		isSet = true
+	}
+
+	if len(o.IOMax) > 0 {
+		out.Io = &grpc.IOResources{}
+		for _, raw := range o.IOMax {
+			entry, err := cgroup.ParseIOMaxEntry(raw)
+			if err != nil {
+				return nil, err
+			}
+			out.Io.Max = append(out.Io.Max, &grpc.IOMaxEntry{
+				Type:  ioTypes[entry.Type],
+				Major: entry.Major,
+				Minor: entry.Minor,
+				Rate:  entry.Rate,
+			})
+		}
+		isSet = true
+	}
+
+	if !isSet {
+		return nil, nil
+	}
+	return &out, nil
+}
@@ -17,6 +17,7 @@ const infiniteGracePeriod = time.Duration(0)
 type StopOptions struct {
 	Name        string
 	GracePeriod time.Duration
+	Signal      string
 }
 
 // NewStop returns a new cobra.Command for stopping Job.
@@ -45,10 +46,11 @@ func NewStop() *cobra.Command {
 
 			status := printer.NewStatus(c.OutOrStdout())
 
-			status.Step("Stopping %q with %s grace period", opts.Name, gracePeriodString(opts.GracePeriod))
+			status.Step("Stopping %q with %s and %s grace period", opts.Name, opts.Signal, gracePeriodString(opts.GracePeriod))
 			_, err = client.Stop(c.Context(), &grpc.StopRequest{
 				Name:        opts.Name,
 				GracePeriod: ptrDuration(opts.GracePeriod),
+				StopSignal:  opts.Signal,
 			})
 			status.End(err == nil)
 			// TODO(simplification): to improve UX, gRPC errors can be translated to more user friendly messages
@@ -58,6 +60,7 @@ func NewStop() *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.DurationVar(&opts.GracePeriod, "grace-period", infiniteGracePeriod, "Represents a period of time given to the Job to terminate gracefully. Zero means infinite.")
+	flags.StringVar(&opts.Signal, "signal", "SIGTERM", "Signal sent to the Job to terminate gracefully, e.g. SIGINT. After grace period, the Job is killed with SIGKILL.")
 	jobPrinter.RegisterFlags(flags)
 
 	return cmd
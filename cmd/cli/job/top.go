@@ -0,0 +1,129 @@
+package job
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/heredoc"
+	"github.com/mszostok/job-runner/internal/cli/printer"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+// clearScreen moves cursor to the top left corner and clears the terminal.
+const clearScreen = "\033[H\033[2J"
+
+// TopOptions holds options for displaying Job's resources usage.
+type TopOptions struct {
+	Watch    bool
+	Interval time.Duration
+}
+
+// NewTop returns a new cobra.Command for displaying Job's resources usage.
+func NewTop() *cobra.Command {
+	var opts TopOptions
+
+	cmd := &cobra.Command{
+		Use:   "top NAME",
+		Short: "Displays resources usage of a given Job",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.WithCLIName(`
+			# Show resources usage of the "episode-42" Job
+			<cli> job top episode-42
+
+			# Show resources usage of the "episode-42" Job every second until the Job finishes
+			<cli> job top episode-42 --watch --interval=1s
+		`, cli.Name),
+		RunE: func(c *cobra.Command, args []string) error {
+			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Printf("while cleaning up connection: %v", err)
+				}
+			}()
+
+			var (
+				name         = args[0]
+				w            = c.OutOrStdout()
+				statsPrinter = printer.StatsTable{}
+			)
+
+			if !opts.Watch {
+				out, err := client.GetStats(c.Context(), &grpc.GetStatsRequest{Name: name})
+				if err != nil { // TODO(simplification): to improve UX, gRPC errors can be translated to a user friendly messages
+					return err
+				}
+				return statsPrinter.Print(toPrinterStats(name, out), w)
+			}
+
+			stream, err := client.WatchStats(c.Context(), &grpc.WatchStatsRequest{
+				Name:     name,
+				Interval: &opts.Interval,
+			})
+			if err != nil {
+				return err
+			}
+
+			smartTerminal := printer.IsSmartTerminal(w)
+			for {
+				out, err := stream.Recv() // it's blocking operation, but it will be released, when stream will be closed/canceled
+				if err != nil {
+					if err == io.EOF {
+						return nil
+					}
+					return err
+				}
+
+				if smartTerminal {
+					fmt.Fprint(w, clearScreen)
+				}
+				if err := statsPrinter.Print(toPrinterStats(name, out), w); err != nil {
+					return err
+				}
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&opts.Watch, "watch", "w", false, "Refreshes resources usage until the Job finishes.")
+	flags.DurationVar(&opts.Interval, "interval", 2*time.Second, "Specifies how often resources usage is refreshed in watch mode.")
+
+	return cmd
+}
+
+func toPrinterStats(name string, in *grpc.GetStatsResponse) printer.JobStats {
+	out := printer.JobStats{
+		Name:   name,
+		Status: in.Status.String(),
+	}
+
+	stats := in.Stats
+	if stats == nil {
+		return out
+	}
+
+	if cpu := stats.Cpu; cpu != nil {
+		out.CPUTime = time.Duration(cpu.UsageUsec) * time.Microsecond
+		out.CPUThrottled = time.Duration(cpu.ThrottledUsec) * time.Microsecond
+	}
+	if mem := stats.Memory; mem != nil {
+		out.MemoryCurrent = mem.Current
+		out.MemoryPeak = mem.Peak
+		if mem.Events != nil {
+			out.OOMKills = mem.Events.OomKill
+		}
+	}
+	for _, entry := range stats.Io {
+		out.IOReadBytes += entry.ReadBytes
+		out.IOWriteBytes += entry.WriteBytes
+	}
+
+	return out
+}
@@ -4,7 +4,10 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/mszostok/job-runner/cmd/cli/auth"
+	"github.com/mszostok/job-runner/cmd/cli/image"
 	"github.com/mszostok/job-runner/cmd/cli/job"
+	"github.com/mszostok/job-runner/cmd/cli/schedule"
+	"github.com/mszostok/job-runner/cmd/cli/workflow"
 	"github.com/mszostok/job-runner/internal/cli"
 	"github.com/mszostok/job-runner/internal/cli/heredoc"
 )
@@ -27,6 +30,9 @@ func NewRoot() *cobra.Command {
 
 	rootCmd.AddCommand(
 		job.NewCmd(),
+		schedule.NewCmd(),
+		workflow.NewCmd(),
+		image.NewCmd(),
 		auth.NewCmd(),
 	)
 
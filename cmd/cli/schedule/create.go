@@ -0,0 +1,137 @@
+package schedule
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mszostok/job-runner/cmd/cli/job"
+	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/heredoc"
+	"github.com/mszostok/job-runner/internal/cli/printer"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+// CreateOptions holds options for creating ScheduledJob.
+type CreateOptions struct {
+	Cron              string
+	ConcurrencyPolicy string
+	HistoryLimit      int32
+	Job               job.RunOptions
+}
+
+// NewCreate returns a new cobra.Command for creating ScheduledJob.
+func NewCreate() *cobra.Command {
+	var opts CreateOptions
+
+	cmd := &cobra.Command{
+		Use:   `create NAME --cron="EXPRESSION" -- [COMMAND] [args...]`,
+		Short: "Creates a ScheduledJob which runs a given command periodically",
+		Args:  cobra.MinimumNArgs(2),
+		Example: heredoc.WithCLIName(`
+			# Run "make e2e-test" every night at 2 AM, unless the previous run is still in progress
+			<cli> schedule create nightly --cron="0 2 * * *" --concurrency-policy=forbid -- make e2e-test
+
+			# Run backup every hour with 1GB of memory and at most 30 minutes
+			<cli> schedule create backup --cron=@hourly --memory-max=1073741824 --timeout=30m -- ./backup.sh
+		`, cli.Name),
+		RunE: func(c *cobra.Command, args []string) error {
+			runCmd, runArgs, err := cli.ExtractExecCommandAfterDash(c, args)
+			if err != nil {
+				return err
+			}
+
+			policy, found := grpc.ConcurrencyPolicy_value[strings.ToUpper(opts.ConcurrencyPolicy)]
+			if !found {
+				return fmt.Errorf("unknown concurrency policy %q, allowed values: %s", opts.ConcurrencyPolicy, availableConcurrencyPolicies())
+			}
+
+			resources, err := opts.Job.Resources.ToGRPC(c.Flags())
+			if err != nil {
+				return err
+			}
+
+			restart, err := opts.Job.Restart.ToGRPC()
+			if err != nil {
+				return err
+			}
+
+			isolation, err := job.IsolationToGRPC(opts.Job.Isolation)
+			if err != nil {
+				return err
+			}
+
+			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Printf("while cleaning up connection: %v", err)
+				}
+			}()
+
+			status := printer.NewStatus(c.OutOrStdout())
+
+			status.Step("Creating ScheduledJob")
+			tpl := &grpc.JobTemplate{
+				Command:             runCmd,
+				Args:                runArgs,
+				Env:                 opts.Job.Env,
+				Resources:           resources,
+				Restart:             restart,
+				Priority:            opts.Job.Priority,
+				WorkingDir:          opts.Job.WorkingDir,
+				User:                opts.Job.User,
+				Group:               opts.Job.Group,
+				SupplementaryGroups: opts.Job.GroupAdd,
+				Umask:               opts.Job.Umask,
+				Isolation:           isolation,
+				Rootfs:              opts.Job.Rootfs,
+				ReadOnlyPaths:       opts.Job.ReadOnlyPaths,
+				SecurityProfile:     opts.Job.SecurityProfile,
+			}
+			if opts.Job.Timeout != 0 {
+				tpl.Timeout = &opts.Job.Timeout
+				tpl.TimeoutGracePeriod = &opts.Job.TimeoutGracePeriod
+			}
+			out, err := client.CreateSchedule(c.Context(), &grpc.CreateScheduleRequest{
+				Name:              args[0],
+				Cron:              opts.Cron,
+				JobTemplate:       tpl,
+				ConcurrencyPolicy: grpc.ConcurrencyPolicy(policy),
+				HistoryLimit:      opts.HistoryLimit,
+			})
+			status.End(err == nil)
+			if err != nil { // TODO(simplification): to improve UX, gRPC errors can be translated to more user friendly messages
+				return err
+			}
+
+			if out.NextScheduleAt != nil {
+				fmt.Fprintf(c.OutOrStdout(), "First Job will be scheduled at %s\n", out.NextScheduleAt.Local().Format(time.RFC3339))
+			}
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.Cron, "cron", "", `Specifies the schedule in cron format, e.g. "*/5 * * * *" or "@daily". It's evaluated in the Agent's local time.`)
+	flags.StringVar(&opts.ConcurrencyPolicy, "concurrency-policy", "allow", fmt.Sprintf("Specifies how to treat a new Job if the previous one is still running. Allowed values: %s.", availableConcurrencyPolicies()))
+	flags.Int32Var(&opts.HistoryLimit, "history-limit", 0, "Specifies the number of the most recent Jobs kept in history. Older finished Jobs are deleted. Zero means Agent's default.")
+	opts.Job.RegisterFlags(flags)
+	// error cannot happen as flag is already declared
+	_ = cmd.MarkFlagRequired("cron")
+
+	return cmd
+}
+
+func availableConcurrencyPolicies() string {
+	out := make([]string, 0, len(grpc.ConcurrencyPolicy_name))
+	for i := 0; i < len(grpc.ConcurrencyPolicy_name); i++ {
+		out = append(out, grpc.ConcurrencyPolicy_name[int32(i)])
+	}
+	return strings.Join(out, ", ")
+}
@@ -0,0 +1,40 @@
+package schedule
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/printer"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+// NewDelete returns a new cobra.Command for deleting ScheduledJob.
+func NewDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete NAME",
+		Aliases: []string{"rm"},
+		Short:   "Deletes a given ScheduledJob. Already created Jobs are not affected",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Printf("while cleaning up connection: %v", err)
+				}
+			}()
+
+			status := printer.NewStatus(c.OutOrStdout())
+
+			status.Step("Deleting %q", args[0])
+			_, err = client.DeleteSchedule(c.Context(), &grpc.DeleteScheduleRequest{Name: args[0]})
+			status.End(err == nil)
+			// TODO(simplification): to improve UX, gRPC errors can be translated to more user friendly messages
+			return err
+		},
+	}
+}
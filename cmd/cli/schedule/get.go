@@ -0,0 +1,70 @@
+package schedule
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/heredoc"
+	"github.com/mszostok/job-runner/internal/cli/printer"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+// GetOptions holds options for listing ScheduledJobs.
+type GetOptions struct {
+	Tenant string
+}
+
+// NewGet returns a new cobra.Command for listing ScheduledJobs.
+func NewGet() *cobra.Command {
+	var opts GetOptions
+
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Lists ScheduledJobs",
+		Args:  cobra.NoArgs,
+		Example: heredoc.WithCLIName(`
+			# List all owned ScheduledJobs
+			<cli> schedule get
+		`, cli.Name),
+		RunE: func(c *cobra.Command, args []string) error {
+			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Printf("while cleaning up connection: %v", err)
+				}
+			}()
+
+			out, err := client.ListSchedules(c.Context(), &grpc.ListSchedulesRequest{Tenant: opts.Tenant})
+			if err != nil { // TODO(simplification): to improve UX, gRPC errors can be translated to a user friendly messages
+				return err
+			}
+
+			schedules := make([]printer.ScheduleDefinition, 0, len(out.Schedules))
+			for _, item := range out.Schedules {
+				schedules = append(schedules, printer.ScheduleDefinition{
+					Name:              item.Name,
+					CreatedBy:         item.CreatedBy,
+					Cron:              item.Cron,
+					ConcurrencyPolicy: item.ConcurrencyPolicy.String(),
+					LastScheduleAt:    item.LastScheduleAt,
+					NextScheduleAt:    item.NextScheduleAt,
+					LastError:         item.LastError,
+					Jobs:              item.Jobs,
+				})
+			}
+
+			schedulePrinter := printer.ScheduleTable{}
+			return schedulePrinter.PrintList(schedules, c.OutOrStdout())
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.Tenant, "tenant", "", "Lists only ScheduledJobs created by a given tenant. Only admin may list ScheduledJobs of other tenants.")
+
+	return cmd
+}
@@ -0,0 +1,20 @@
+package schedule
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewCmd returns a new cobra.Command subcommand for ScheduledJob related operations.
+func NewCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:   "schedule",
+		Short: "This command consists of multiple subcommands managing ScheduledJobs, which run Jobs periodically",
+	}
+
+	root.AddCommand(
+		NewCreate(),
+		NewGet(),
+		NewDelete(),
+	)
+	return root
+}
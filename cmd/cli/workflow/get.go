@@ -0,0 +1,65 @@
+package workflow
+
+import (
+	"log"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/heredoc"
+	"github.com/mszostok/job-runner/internal/cli/printer"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+// NewGet returns a new cobra.Command for getting Workflow with its steps' status.
+func NewGet() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get NAME",
+		Short: "Prints Workflow status together with its steps' status",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.WithCLIName(`
+			# Get the "release" Workflow
+			<cli> workflow get release
+		`, cli.Name),
+		RunE: func(c *cobra.Command, args []string) error {
+			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Printf("while cleaning up connection: %v", err)
+				}
+			}()
+
+			out, err := client.GetWorkflow(c.Context(), &grpc.GetWorkflowRequest{Name: args[0]})
+			if err != nil { // TODO(simplification): to improve UX, gRPC errors can be translated to a user friendly messages
+				return err
+			}
+
+			wf := printer.WorkflowDefinition{
+				Name:       out.Name,
+				CreatedBy:  out.CreatedBy,
+				Status:     strings.TrimPrefix(out.Status.String(), "WORKFLOW_"),
+				CreatedAt:  out.CreatedAt,
+				FinishedAt: out.FinishedAt,
+			}
+			for _, step := range out.Steps {
+				wf.Steps = append(wf.Steps, printer.WorkflowStep{
+					Name:       step.Name,
+					DependsOn:  step.DependsOn,
+					JobName:    step.JobName,
+					Status:     strings.TrimPrefix(step.Status.String(), "STEP_"),
+					ExitCode:   int(step.ExitCode),
+					StartedAt:  step.StartedAt,
+					FinishedAt: step.FinishedAt,
+					Error:      step.Error,
+				})
+			}
+
+			workflowPrinter := printer.WorkflowTable{}
+			return workflowPrinter.Print(wf, c.OutOrStdout())
+		},
+	}
+}
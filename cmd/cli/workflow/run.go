@@ -0,0 +1,101 @@
+package workflow
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mszostok/job-runner/internal/cli"
+	"github.com/mszostok/job-runner/internal/cli/heredoc"
+	"github.com/mszostok/job-runner/internal/cli/printer"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+// RunOptions holds options for submitting Workflow.
+type RunOptions struct {
+	File string
+}
+
+// NewRun returns a new cobra.Command for submitting Workflow.
+func NewRun() *cobra.Command {
+	var opts RunOptions
+
+	cmd := &cobra.Command{
+		Use:   "run -f FILE",
+		Short: "Submits a Workflow defined in a given YAML file",
+		Long: heredoc.Doc(`
+			Submits a Workflow, which is a set of steps that together with their dependencies form a directed acyclic graph.
+			Each step runs a single Job. The step is started only if all its dependencies succeeded, otherwise it's skipped.
+
+			Steps' Jobs are named "<workflow name>-<step name>-<random suffix>". Once the Workflow is finished,
+			it can be submitted again, which starts its new run.
+		`),
+		Args: cobra.NoArgs,
+		Example: heredoc.WithCLIName(`
+			# Submit a Workflow defined in the release.yaml file
+			<cli> workflow run -f release.yaml
+
+			# Example Workflow definition
+			name: release
+			steps:
+			  - name: build
+			    command: make
+			    args: ["build"]
+			    timeout: 10m
+			    resources:
+			      cpus: 2
+			      memoryMax: 4294967296
+			  - name: test
+			    dependsOn: ["build"]
+			    command: make
+			    args: ["test"]
+			    restart:
+			      policy: on_failure
+			      maxRestarts: 2
+			      backoff: 5s
+		`, cli.Name),
+		RunE: func(c *cobra.Command, args []string) error {
+			spec, err := LoadSpec(opts.File)
+			if err != nil {
+				return err
+			}
+			steps, err := spec.ToGRPC()
+			if err != nil {
+				return err
+			}
+
+			client, cleanup, err := cli.NewDefaultGRPCAgentClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Printf("while cleaning up connection: %v", err)
+				}
+			}()
+
+			status := printer.NewStatus(c.OutOrStdout())
+
+			status.Step("Submitting Workflow")
+			_, err = client.SubmitWorkflow(c.Context(), &grpc.SubmitWorkflowRequest{
+				Name:  spec.Name,
+				Steps: steps,
+			})
+			status.End(err == nil)
+			if err != nil { // TODO(simplification): to improve UX, gRPC errors can be translated to more user friendly messages
+				return err
+			}
+
+			fmt.Fprintf(c.OutOrStdout(), "To check Workflow progress, run: %s workflow get %s\n", cli.Name, spec.Name)
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.File, "file", "f", "", "Specifies path to a YAML file with Workflow definition.")
+	// error cannot happen as flag is already declared
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
@@ -0,0 +1,175 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/mszostok/job-runner/cmd/cli/job"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+)
+
+// defaultTimeoutGracePeriod is aligned with the default value of the "job run --timeout-grace-period" flag.
+const defaultTimeoutGracePeriod = 10 * time.Second
+
+// Spec describes Workflow defined in a YAML file.
+type Spec struct {
+	Name  string     `json:"name"`
+	Steps []StepSpec `json:"steps"`
+}
+
+// StepSpec describes a single Workflow's step. Its fields reflect the "job run" flags.
+type StepSpec struct {
+	Name               string         `json:"name"`
+	DependsOn          []string       `json:"dependsOn"`
+	Command            string         `json:"command"`
+	Args               []string       `json:"args"`
+	Env                []string       `json:"env"`
+	Timeout            Duration       `json:"timeout"`
+	TimeoutGracePeriod *Duration      `json:"timeoutGracePeriod"`
+	Restart            *RestartSpec   `json:"restart"`
+	Resources          *ResourcesSpec `json:"resources"`
+	Priority           int32          `json:"priority"`
+	WorkingDir         string         `json:"workingDir"`
+	User               string         `json:"user"`
+	Group              string         `json:"group"`
+	GroupAdd           []string       `json:"groupAdd"`
+	Umask              string         `json:"umask"`
+	Isolation          string         `json:"isolation"`
+	Rootfs             string         `json:"rootfs"`
+	ReadOnlyPaths      []string       `json:"readOnlyPaths"`
+	SecurityProfile    string         `json:"securityProfile"`
+}
+
+// RestartSpec describes step's Job restart policy.
+type RestartSpec struct {
+	Policy      string   `json:"policy"`
+	MaxRestarts int32    `json:"maxRestarts"`
+	Backoff     Duration `json:"backoff"`
+}
+
+// ResourcesSpec describes step's Job system resources limits.
+type ResourcesSpec struct {
+	CPUs       *float64 `json:"cpus"`
+	CPUSetCPUs string   `json:"cpusetCpus"`
+	CPUSetMems string   `json:"cpusetMems"`
+	MemoryMin  int64    `json:"memoryMin"`
+	MemoryMax  int64    `json:"memoryMax"`
+	IOMax      []string `json:"ioMax"`
+}
+
+// Duration allows specifying durations in a human-readable format, e.g. "1m30s".
+type Duration time.Duration
+
+// UnmarshalJSON parses duration using time.ParseDuration.
+func (d *Duration) UnmarshalJSON(raw []byte) error {
+	var in string
+	if err := json.Unmarshal(raw, &in); err != nil {
+		return fmt.Errorf("duration must be a string, e.g. \"1m30s\": %w", err)
+	}
+	parsed, err := time.ParseDuration(in)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// LoadSpec reads Workflow specification from a given YAML file.
+func LoadSpec(path string) (*Spec, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var out Spec
+	if err := yaml.UnmarshalStrict(raw, &out); err != nil {
+		return nil, fmt.Errorf("while parsing %s: %w", path, err)
+	}
+	return &out, nil
+}
+
+// ToGRPC returns Workflow steps in gRPC format.
+func (s Spec) ToGRPC() ([]*grpc.WorkflowStep, error) {
+	out := make([]*grpc.WorkflowStep, 0, len(s.Steps))
+	for _, step := range s.Steps {
+		tpl, err := step.toJobTemplate()
+		if err != nil {
+			return nil, fmt.Errorf("step %q: %w", step.Name, err)
+		}
+		out = append(out, &grpc.WorkflowStep{
+			Name:        step.Name,
+			DependsOn:   step.DependsOn,
+			JobTemplate: tpl,
+		})
+	}
+	return out, nil
+}
+
+func (s StepSpec) toJobTemplate() (*grpc.JobTemplate, error) {
+	tpl := &grpc.JobTemplate{
+		Command:             s.Command,
+		Args:                s.Args,
+		Env:                 s.Env,
+		Priority:            s.Priority,
+		WorkingDir:          s.WorkingDir,
+		User:                s.User,
+		Group:               s.Group,
+		SupplementaryGroups: s.GroupAdd,
+		Umask:               s.Umask,
+		Rootfs:              s.Rootfs,
+		ReadOnlyPaths:       s.ReadOnlyPaths,
+		SecurityProfile:     s.SecurityProfile,
+	}
+
+	isolation, err := job.IsolationToGRPC(s.Isolation)
+	if err != nil {
+		return nil, err
+	}
+	tpl.Isolation = isolation
+
+	if s.Timeout != 0 {
+		timeout := time.Duration(s.Timeout)
+		grace := defaultTimeoutGracePeriod
+		if s.TimeoutGracePeriod != nil {
+			grace = time.Duration(*s.TimeoutGracePeriod)
+		}
+		tpl.Timeout = &timeout
+		tpl.TimeoutGracePeriod = &grace
+	}
+
+	if s.Restart != nil {
+		restart, err := job.RestartOptions{
+			Policy:      s.Restart.Policy,
+			MaxRestarts: s.Restart.MaxRestarts,
+			Backoff:     time.Duration(s.Restart.Backoff),
+		}.ToGRPC()
+		if err != nil {
+			return nil, err
+		}
+		tpl.Restart = restart
+	}
+
+	if s.Resources != nil {
+		opts := job.ResourcesOptions{
+			CPUSetCPUs: s.Resources.CPUSetCPUs,
+			CPUSetMems: s.Resources.CPUSetMems,
+			MemoryMin:  s.Resources.MemoryMin,
+			MemoryMax:  s.Resources.MemoryMax,
+			IOMax:      s.Resources.IOMax,
+		}
+		if s.Resources.CPUs != nil {
+			opts.CPUs = *s.Resources.CPUs
+		}
+		resources, err := opts.ToGRPCWithCPUs(s.Resources.CPUs != nil)
+		if err != nil {
+			return nil, err
+		}
+		tpl.Resources = resources
+	}
+
+	return tpl, nil
+}
@@ -0,0 +1,19 @@
+package workflow
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewCmd returns a new cobra.Command subcommand for Workflow related operations.
+func NewCmd() *cobra.Command {
+	root := &cobra.Command{
+		Use:   "workflow",
+		Short: "This command consists of multiple subcommands managing Workflows, which run Jobs in dependencies order",
+	}
+
+	root.AddCommand(
+		NewRun(),
+		NewGet(),
+	)
+	return root
+}
@@ -63,7 +63,7 @@ main() {
 
 	protoc -I="${REPO_ROOT_DIR}/proto/" \
 		-I="$GOPATH/src" \
-		--gogo_out="Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types:." \
+		--gogo_out="Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types,Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types:." \
 		--go-grpc_out="." \
 		"${REPO_ROOT_DIR}/proto/job_runner.proto"
 
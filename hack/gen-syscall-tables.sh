@@ -0,0 +1,54 @@
+#!/usr/bin/env bash
+
+# standard bash error handling
+set -o nounset # treat unset variables as an error and exit immediately.
+set -o errexit # exit immediately when a command fails.
+set -E         # needs to be set if we want the ERR trap
+
+CURRENT_DIR=$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)
+REPO_ROOT_DIR=$(cd "${CURRENT_DIR}/.." && pwd)
+readonly CURRENT_DIR
+readonly REPO_ROOT_DIR
+
+readonly ARCHS=(amd64 arm64)
+readonly OUTPUT_DIR="${REPO_ROOT_DIR}/pkg/security"
+
+# shellcheck source=./hack/lib/utilities.sh
+source "${CURRENT_DIR}/lib/utilities.sh" || { echo 'Cannot load CI utilities.' exit 1; }
+
+# Generates syscall names table for a given architecture from constants defined in golang.org/x/sys/unix,
+# so seccomp filters can refer to syscalls by names.
+gen::syscall_table() {
+	local arch=$1
+	local src="$2/zsysnum_linux_${arch}.go"
+	local dst="${OUTPUT_DIR}/zsyscalls_${arch}.go"
+
+	{
+		echo "// Code generated by hack/gen-syscall-tables.sh. DO NOT EDIT."
+		echo
+		echo "package security"
+		echo
+		echo 'import "golang.org/x/sys/unix"'
+		echo
+		echo "// syscalls maps syscall names to their numbers on ${arch}."
+		echo "var syscalls = map[string]uint32{"
+		grep -oE '^\s+SYS_[A-Z0-9_]+\s+=' "${src}" | awk '{ name = substr($1, 5); printf "\t\"%s\": unix.%s,\n", tolower(name), $1 }'
+		echo "}"
+	} >"${dst}"
+	gofmt -w "${dst}"
+}
+
+main() {
+	shout "Generating syscall tables..."
+
+	local sys_dir
+	sys_dir=$(cd "${REPO_ROOT_DIR}" && go list -m -f '{{.Dir}}' golang.org/x/sys)/unix
+
+	for arch in "${ARCHS[@]}"; do
+		gen::syscall_table "${arch}" "${sys_dir}"
+	done
+
+	shout "Generation completed successfully."
+}
+
+main
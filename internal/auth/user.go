@@ -58,6 +58,11 @@ func (u *User) CheckAuthorized(createdBy string) error {
 	return nil
 }
 
+// IsAdmin returns true if a given user has the admin role assigned.
+func (u *User) IsAdmin() bool {
+	return u.hasRole(AdminRole)
+}
+
 func (u *User) hasRole(exp string) bool {
 	_, found := u.Roles[exp]
 	return found
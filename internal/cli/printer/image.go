@@ -0,0 +1,43 @@
+package printer
+
+import (
+	"io"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// ImageDefinition holds rootfs image details.
+type ImageDefinition struct {
+	Name      string
+	CreatedBy string
+	CreatedAt *time.Time
+	// HostPath is set only for images registered from the Agent's host directory.
+	HostPath string
+}
+
+// ImageTable prints rootfs images in table format.
+type ImageTable struct{}
+
+// PrintList creates table with a row for each provided item and writes it to a given writer.
+func (p *ImageTable) PrintList(in []ImageDefinition, w io.Writer) error {
+	table := tablewriter.NewWriter(w)
+	table.SetAutoWrapText(true)
+	table.SetColumnSeparator(" ")
+	table.SetBorder(false)
+	table.SetRowLine(true)
+
+	table.SetHeader([]string{"Name", "Created by", "Created at", "Host path"})
+	for _, item := range in {
+		table.Append([]string{
+			item.Name,
+			item.CreatedBy,
+			formatTime(item.CreatedAt),
+			item.HostPath,
+		})
+	}
+
+	table.Render()
+
+	return nil
+}
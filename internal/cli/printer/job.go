@@ -5,21 +5,69 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 )
 
 type JobDefinition struct {
-	Name      string `json:"name"`
-	CreatedBy string `json:"createdBy"`
-	Status    string `json:"status"`
-	ExitCode  int    `json:"exitCode"`
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	CreatedBy  string     `json:"createdBy"`
+	PID        int        `json:"pid,omitempty"`
+	Status     string     `json:"status"`
+	ExitCode   int        `json:"exitCode"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	// TerminationReason specifies why the Job was terminated. Empty if the Job exited on its own.
+	TerminationReason string `json:"terminationReason,omitempty"`
+	// Signal specifies the name of the signal that killed the Job's process.
+	Signal string `json:"signal,omitempty"`
+	// StoppedBy specifies the tenant that requested the Job stop.
+	StoppedBy string `json:"stoppedBy,omitempty"`
+	// Duration specifies how long the Job ran, or is running if it's not finished yet.
+	Duration string `json:"duration,omitempty"`
+	// Restarts specifies how many times the Job was restarted.
+	Restarts int `json:"restarts,omitempty"`
+	// Attempts holds all Job's executions. Set only if the Job was restarted.
+	Attempts []JobAttempt `json:"attempts,omitempty"`
+	// QueuePosition specifies the Job's position in the queue, starting from 1. Set only if the Job is queued.
+	QueuePosition int `json:"queuePosition,omitempty"`
+	// SecurityProfile specifies the name of the security profile the Job was hardened with.
+	SecurityProfile string `json:"securityProfile,omitempty"`
+	// Error specifies why the Job's process could not be started.
+	Error string `json:"error,omitempty"`
+}
+
+// JobAttempt holds a single Job's execution.
+type JobAttempt struct {
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	ExitCode   int        `json:"exitCode"`
+	Signal     string     `json:"signal,omitempty"`
+}
+
+// JobDuration returns duration between Job start and finish. If Job is not finished, duration is counted till now.
+// Returns empty string if start time is unknown.
+func JobDuration(startedAt, finishedAt *time.Time) string {
+	if startedAt == nil {
+		return ""
+	}
+
+	end := time.Now()
+	if finishedAt != nil {
+		end = *finishedAt
+	}
+	return end.Sub(*startedAt).Round(time.Second).String()
 }
 
 // Printer is an interface that knows how to print objects.
 type Printer interface {
 	// Print receives an object, formats it and prints it to a writer.
 	Print(in JobDefinition, w io.Writer) error
+	// PrintList receives a list of objects, formats them and prints them to a writer.
+	PrintList(in []JobDefinition, w io.Writer) error
 }
 
 // JobPrinter provides functionality to print a given resource in requested format.
@@ -59,6 +107,16 @@ func (r *JobPrinter) Print(in JobDefinition) error {
 	return printer.Print(in, r.writer)
 }
 
+// PrintList prints received objects in requested format.
+func (r *JobPrinter) PrintList(in []JobDefinition) error {
+	printer, found := r.printers[r.outputFormat]
+	if !found {
+		return fmt.Errorf("printer %q is not available", r.outputFormat)
+	}
+
+	return printer.PrintList(in, r.writer)
+}
+
 func (r *JobPrinter) availablePrinters() string {
 	var out []string
 	for key := range r.printers {
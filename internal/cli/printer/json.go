@@ -13,6 +13,18 @@ type JSON struct{}
 
 // Print marshals input data to JSON format and writes it to a given writer.
 func (p *JSON) Print(in JobDefinition, w io.Writer) error {
+	return p.print(in, w)
+}
+
+// PrintList marshals input data to JSON array and writes it to a given writer.
+func (p *JSON) PrintList(in []JobDefinition, w io.Writer) error {
+	if in == nil {
+		in = []JobDefinition{} // print empty array instead of null
+	}
+	return p.print(in, w)
+}
+
+func (p *JSON) print(in interface{}, w io.Writer) error {
 	out, err := prettyjson.Marshal(in)
 	if err != nil {
 		return err
@@ -3,6 +3,7 @@ package printer_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/sebdah/goldie/v2"
 	"github.com/spf13/pflag"
@@ -49,10 +50,16 @@ func TestJobPrinterOutput(t *testing.T) {
 			jobPrinter.RegisterFlags(flags)
 
 			job := printer.JobDefinition{
-				Name:      "YourAdHere",
-				CreatedBy: "testing",
-				Status:    "SUCCEEDED",
-				ExitCode:  0,
+				ID:         "4f1c2a9be07d53a6c8e1",
+				Name:       "YourAdHere",
+				CreatedBy:  "testing",
+				PID:        4242,
+				Status:     "SUCCEEDED",
+				ExitCode:   0,
+				CreatedAt:  timePtr("2022-03-08T10:00:00Z"),
+				StartedAt:  timePtr("2022-03-08T10:00:01Z"),
+				FinishedAt: timePtr("2022-03-08T10:05:31Z"),
+				Duration:   "5m30s",
 			}
 
 			// when
@@ -69,6 +76,275 @@ func TestJobPrinterOutput(t *testing.T) {
 	}
 }
 
+// TestJobListPrinterOutput tests that Job list outputter works properly in all formats.
+//
+// This test is based on golden file. To update golden files, run:
+//   go test ./internal/cli/printer/... -run "^TestJobListPrinterOutput$" -update
+func TestJobListPrinterOutput(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+	}{
+		{
+			name:   "Should print Jobs in YAML format",
+			output: "yaml",
+		},
+		{
+			name:   "Should print Jobs in JSON format",
+			output: "json",
+		},
+		{
+			name:   "Should print Jobs in Table format",
+			output: "table",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			buff := &bytes.Buffer{}
+			jobPrinter := printer.NewForJob(buff)
+
+			flags := pflag.NewFlagSet("testing", pflag.ContinueOnError)
+			jobPrinter.RegisterFlags(flags)
+
+			jobs := []printer.JobDefinition{
+				{
+					ID:         "4f1c2a9be07d53a6c8e1",
+					Name:       "YourAdHere",
+					CreatedBy:  "testing",
+					Status:     "SUCCEEDED",
+					ExitCode:   0,
+					CreatedAt:  timePtr("2022-03-08T10:00:00Z"),
+					StartedAt:  timePtr("2022-03-08T10:00:01Z"),
+					FinishedAt: timePtr("2022-03-08T10:05:31Z"),
+					Duration:   "5m30s",
+				},
+				{
+					ID:        "9d0e8b7c6a5f4e3d2c1b",
+					Name:      "episode-42",
+					CreatedBy: "Ricky",
+					Status:    "FAILED",
+					ExitCode:  42,
+					Restarts:  2,
+				},
+				{
+					ID:                "0a1b2c3d4e5f60718293",
+					Name:              "memory-hog",
+					CreatedBy:         "Ricky",
+					Status:            "TERMINATED",
+					ExitCode:          -1,
+					StartedAt:         timePtr("2022-03-08T10:00:01Z"),
+					FinishedAt:        timePtr("2022-03-08T10:00:11Z"),
+					TerminationReason: "OOM_KILLED",
+					Signal:            "SIGKILL",
+					Duration:          "10s",
+				},
+				{
+					ID:            "e5d4c3b2a1f0e9d8c7b6",
+					Name:          "nightly-build",
+					CreatedBy:     "Morty",
+					Status:        "QUEUED",
+					CreatedAt:     timePtr("2022-03-08T10:00:00Z"),
+					QueuePosition: 3,
+				},
+			}
+
+			// when
+			err := flags.Set("output", test.output)
+			require.NoError(t, err)
+
+			err = jobPrinter.PrintList(jobs)
+
+			// then
+			require.NoError(t, err)
+			g := goldie.New(t, goldie.WithNameSuffix(".golden.txt"))
+			g.Assert(t, t.Name(), buff.Bytes())
+		})
+	}
+}
+
+// TestStatsTableOutput tests that Job stats outputter works properly.
+//
+// This test is based on golden file. To update golden files, run:
+//   go test ./internal/cli/printer/... -run "^TestStatsTableOutput$" -update
+func TestStatsTableOutput(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats printer.JobStats
+	}{
+		{
+			name: "Should print Job stats",
+			stats: printer.JobStats{
+				Name:          "episode-42",
+				Status:        "RUNNING",
+				CPUTime:       1500 * time.Millisecond,
+				CPUThrottled:  300 * time.Millisecond,
+				MemoryCurrent: 4 * 1024 * 1024,
+				MemoryPeak:    6 * 1024 * 1024,
+				IOReadBytes:   1459200,
+				IOWriteBytes:  314773504,
+				OOMKills:      1,
+			},
+		},
+		{
+			name: "Should print Job stats without memory peak",
+			stats: printer.JobStats{
+				Name:          "episode-42",
+				Status:        "SUCCEEDED",
+				MemoryCurrent: 512,
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			buff := &bytes.Buffer{}
+			statsPrinter := printer.StatsTable{}
+
+			// when
+			err := statsPrinter.Print(test.stats, buff)
+
+			// then
+			require.NoError(t, err)
+			g := goldie.New(t, goldie.WithNameSuffix(".golden.txt"))
+			g.Assert(t, t.Name(), buff.Bytes())
+		})
+	}
+}
+
+// TestScheduleTableOutput tests that ScheduledJobs outputter works properly.
+//
+// This test is based on golden file. To update golden files, run:
+//   go test ./internal/cli/printer/... -run "^TestScheduleTableOutput$" -update
+func TestScheduleTableOutput(t *testing.T) {
+	// given
+	buff := &bytes.Buffer{}
+	schedulePrinter := printer.ScheduleTable{}
+
+	schedules := []printer.ScheduleDefinition{
+		{
+			Name:              "nightly",
+			CreatedBy:         "Ricky",
+			Cron:              "0 2 * * *",
+			ConcurrencyPolicy: "FORBID",
+			LastScheduleAt:    timePtr("2022-03-08T02:00:00Z"),
+			NextScheduleAt:    timePtr("2022-03-09T02:00:00Z"),
+			Jobs:              []string{"nightly-27444360", "nightly-27445800"},
+		},
+		{
+			Name:              "backup",
+			CreatedBy:         "Morty",
+			Cron:              "@hourly",
+			ConcurrencyPolicy: "ALLOW",
+			NextScheduleAt:    timePtr("2022-03-08T11:00:00Z"),
+			LastError:         "while running Job: service is shutting down",
+		},
+	}
+
+	// when
+	err := schedulePrinter.PrintList(schedules, buff)
+
+	// then
+	require.NoError(t, err)
+	g := goldie.New(t, goldie.WithNameSuffix(".golden.txt"))
+	g.Assert(t, t.Name(), buff.Bytes())
+}
+
+// TestImageTableOutput tests that rootfs images outputter works properly.
+//
+// This test is based on golden file. To update golden files, run:
+//   go test ./internal/cli/printer/... -run "^TestImageTableOutput$" -update
+func TestImageTableOutput(t *testing.T) {
+	// given
+	buff := &bytes.Buffer{}
+	imagePrinter := printer.ImageTable{}
+
+	images := []printer.ImageDefinition{
+		{
+			Name:      "alpine:3.15",
+			CreatedBy: "Ricky",
+			CreatedAt: timePtr("2022-03-08T10:00:00Z"),
+		},
+		{
+			Name:      "debian",
+			CreatedBy: "admin",
+			CreatedAt: timePtr("2022-03-09T08:30:00Z"),
+			HostPath:  "/srv/rootfs/debian",
+		},
+	}
+
+	// when
+	err := imagePrinter.PrintList(images, buff)
+
+	// then
+	require.NoError(t, err)
+	g := goldie.New(t, goldie.WithNameSuffix(".golden.txt"))
+	g.Assert(t, t.Name(), buff.Bytes())
+}
+
+// TestWorkflowTableOutput tests that Workflow outputter works properly.
+//
+// This test is based on golden file. To update golden files, run:
+//   go test ./internal/cli/printer/... -run "^TestWorkflowTableOutput$" -update
+func TestWorkflowTableOutput(t *testing.T) {
+	// given
+	buff := &bytes.Buffer{}
+	workflowPrinter := printer.WorkflowTable{}
+
+	wf := printer.WorkflowDefinition{
+		Name:       "release",
+		CreatedBy:  "Ricky",
+		Status:     "FAILED",
+		CreatedAt:  timePtr("2022-03-08T10:00:00Z"),
+		FinishedAt: timePtr("2022-03-08T10:05:00Z"),
+		Steps: []printer.WorkflowStep{
+			{
+				Name:       "fetch",
+				JobName:    "release-fetch",
+				Status:     "SUCCEEDED",
+				StartedAt:  timePtr("2022-03-08T10:00:00Z"),
+				FinishedAt: timePtr("2022-03-08T10:01:00Z"),
+			},
+			{
+				Name:       "build",
+				DependsOn:  []string{"fetch"},
+				JobName:    "release-build",
+				Status:     "FAILED",
+				ExitCode:   2,
+				StartedAt:  timePtr("2022-03-08T10:01:00Z"),
+				FinishedAt: timePtr("2022-03-08T10:05:00Z"),
+			},
+			{
+				Name:       "lint",
+				DependsOn:  []string{"fetch"},
+				JobName:    "release-lint",
+				Status:     "FAILED",
+				FinishedAt: timePtr("2022-03-08T10:01:00Z"),
+				Error:      "while running Job: Job \"release-lint\" already exists",
+			},
+			{
+				Name:       "publish",
+				DependsOn:  []string{"build", "lint"},
+				JobName:    "release-publish",
+				Status:     "SKIPPED",
+				FinishedAt: timePtr("2022-03-08T10:05:00Z"),
+			},
+		},
+	}
+
+	// when
+	err := workflowPrinter.Print(wf, buff)
+
+	// then
+	require.NoError(t, err)
+	g := goldie.New(t, goldie.WithNameSuffix(".golden.txt"))
+	g.Assert(t, t.Name(), buff.Bytes())
+}
+
 // TestStatusPrinterOutput tests that status outputter works properly.
 //
 // This test is based on golden file. To update golden files, run:
@@ -105,3 +381,11 @@ func TestStatusPrinterOutput(t *testing.T) {
 		})
 	}
 }
+
+func timePtr(in string) *time.Time {
+	out, err := time.Parse(time.RFC3339, in)
+	if err != nil {
+		panic(err)
+	}
+	return &out
+}
@@ -0,0 +1,53 @@
+package printer
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// ScheduleDefinition holds ScheduledJob details.
+type ScheduleDefinition struct {
+	Name              string
+	CreatedBy         string
+	Cron              string
+	ConcurrencyPolicy string
+	LastScheduleAt    *time.Time
+	NextScheduleAt    *time.Time
+	// LastError describes why the last Job couldn't be created.
+	LastError string
+	// Jobs holds names of the most recent Jobs, the oldest first.
+	Jobs []string
+}
+
+// ScheduleTable prints ScheduledJobs in table format.
+type ScheduleTable struct{}
+
+// PrintList creates table with a row for each provided item and writes it to a given writer.
+func (p *ScheduleTable) PrintList(in []ScheduleDefinition, w io.Writer) error {
+	table := tablewriter.NewWriter(w)
+	table.SetAutoWrapText(true)
+	table.SetColumnSeparator(" ")
+	table.SetBorder(false)
+	table.SetRowLine(true)
+
+	table.SetHeader([]string{"Name", "Created by", "Cron", "Concurrency", "Last schedule", "Next schedule", "Recent Jobs", "Last error"})
+	for _, item := range in {
+		table.Append([]string{
+			item.Name,
+			item.CreatedBy,
+			item.Cron,
+			item.ConcurrencyPolicy,
+			formatTime(item.LastScheduleAt),
+			formatTime(item.NextScheduleAt),
+			strings.Join(item.Jobs, ", "),
+			item.LastError,
+		})
+	}
+
+	table.Render()
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// JobStats holds Job's resources usage.
+type JobStats struct {
+	Name   string
+	Status string
+	// CPUTime specifies total CPU time consumed by the Job.
+	CPUTime time.Duration
+	// CPUThrottled specifies the total time for which the Job was throttled.
+	CPUThrottled time.Duration
+	// MemoryCurrent specifies the amount of memory currently being used by the Job in bytes.
+	MemoryCurrent uint64
+	// MemoryPeak specifies the max memory usage recorded for the Job in bytes. Zero if not supported.
+	MemoryPeak uint64
+	// IOReadBytes specifies the number of bytes read by the Job from all devices.
+	IOReadBytes uint64
+	// IOWriteBytes specifies the number of bytes written by the Job to all devices.
+	IOWriteBytes uint64
+	// OOMKills specifies the number of the Job's processes killed by OOM killer.
+	OOMKills uint64
+}
+
+// StatsTable prints Job's resources usage in table format.
+type StatsTable struct{}
+
+// Print creates table with provided data and writes it to a given writer.
+func (p *StatsTable) Print(in JobStats, w io.Writer) error {
+	table := tablewriter.NewWriter(w)
+	table.SetAutoWrapText(true)
+	table.SetColumnSeparator(" ")
+	table.SetBorder(false)
+	table.SetRowLine(true)
+
+	peak := "n/a"
+	if in.MemoryPeak > 0 {
+		peak = formatBytes(in.MemoryPeak)
+	}
+
+	table.SetHeader([]string{"Name", "Status", "CPU time", "CPU throttled", "Memory", "Memory peak", "IO read", "IO write", "OOM kills"})
+	table.Append([]string{
+		in.Name,
+		in.Status,
+		in.CPUTime.Round(time.Millisecond).String(),
+		in.CPUThrottled.Round(time.Millisecond).String(),
+		formatBytes(in.MemoryCurrent),
+		peak,
+		formatBytes(in.IOReadBytes),
+		formatBytes(in.IOWriteBytes),
+		strconv.FormatUint(in.OOMKills, 10),
+	})
+
+	table.Render()
+
+	return nil
+}
+
+// formatBytes returns a human-readable size using binary prefixes, e.g. 1.5MiB.
+func formatBytes(in uint64) string {
+	const unit = 1024
+	if in < unit {
+		return fmt.Sprintf("%dB", in)
+	}
+
+	div, exp := uint64(unit), 0
+	for n := in / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(in)/float64(div), "KMGTPE"[exp])
+}
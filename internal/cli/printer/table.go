@@ -1,8 +1,10 @@
 package printer
 
 import (
+	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"github.com/olekukonko/tablewriter"
 )
@@ -14,21 +16,55 @@ type Table struct{}
 
 // Print creates table with provided data and writes it to a given writer.
 func (p *Table) Print(in JobDefinition, w io.Writer) error {
+	return p.PrintList([]JobDefinition{in}, w)
+}
+
+// PrintList creates table with a row for each provided item and writes it to a given writer.
+func (p *Table) PrintList(in []JobDefinition, w io.Writer) error {
 	table := tablewriter.NewWriter(w)
 	table.SetAutoWrapText(true)
 	table.SetColumnSeparator(" ")
 	table.SetBorder(false)
 	table.SetRowLine(true)
 
-	table.SetHeader([]string{"Name", "Created by", "Status", "Exit code"})
-	table.Append([]string{
-		in.Name,
-		in.CreatedBy,
-		in.Status,
-		strconv.Itoa(in.ExitCode),
-	})
+	table.SetHeader([]string{"ID", "Name", "Created by", "Status", "Exit code", "Started", "Finished", "Duration"})
+	for _, item := range in {
+		table.Append([]string{
+			item.ID,
+			item.Name,
+			item.CreatedBy,
+			formatStatus(item),
+			strconv.Itoa(item.ExitCode),
+			formatTime(item.StartedAt),
+			formatTime(item.FinishedAt),
+			item.Duration,
+		})
+	}
 
 	table.Render()
 
 	return nil
 }
+
+// formatStatus appends the termination reason, so e.g. OOM kills can be distinguished from stop requests.
+// It also appends the queue position of queued Job, and the number of restarts, if any.
+func formatStatus(in JobDefinition) string {
+	out := in.Status
+	if in.TerminationReason != "" {
+		out = fmt.Sprintf("%s (%s)", out, in.TerminationReason)
+	}
+	if in.QueuePosition > 0 {
+		out = fmt.Sprintf("%s, position: %d", out, in.QueuePosition)
+	}
+	if in.Restarts > 0 {
+		out = fmt.Sprintf("%s, restarts: %d", out, in.Restarts)
+	}
+	return out
+}
+
+func formatTime(in *time.Time) string {
+	if in == nil {
+		return ""
+	}
+	return in.Format(time.RFC3339)
+}
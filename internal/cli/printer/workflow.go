@@ -0,0 +1,77 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// WorkflowDefinition holds Workflow details.
+type WorkflowDefinition struct {
+	Name       string
+	CreatedBy  string
+	Status     string
+	CreatedAt  *time.Time
+	FinishedAt *time.Time
+	Steps      []WorkflowStep
+}
+
+// WorkflowStep holds Workflow's step details.
+type WorkflowStep struct {
+	Name      string
+	DependsOn []string
+	JobName   string
+	Status    string
+	// ExitCode is printed only for finished steps which Job was started.
+	ExitCode   int
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	// Error describes why the step's Job couldn't be started or awaited.
+	Error string
+}
+
+// WorkflowTable prints Workflow with its steps in table format.
+type WorkflowTable struct{}
+
+// Print writes Workflow summary followed by a table with a row for each step.
+func (p *WorkflowTable) Print(in WorkflowDefinition, w io.Writer) error {
+	summary := fmt.Sprintf("Workflow %q created by %s: %s", in.Name, in.CreatedBy, in.Status)
+	if in.FinishedAt != nil {
+		summary += fmt.Sprintf(", finished at %s", formatTime(in.FinishedAt))
+	}
+	if _, err := fmt.Fprintf(w, "%s\n\n", summary); err != nil {
+		return err
+	}
+
+	table := tablewriter.NewWriter(w)
+	table.SetAutoWrapText(true)
+	table.SetColumnSeparator(" ")
+	table.SetBorder(false)
+	table.SetRowLine(true)
+
+	table.SetHeader([]string{"Step", "Depends on", "Job", "Status", "Exit code", "Started", "Finished", "Error"})
+	for _, step := range in.Steps {
+		exitCode := ""
+		if step.StartedAt != nil && step.FinishedAt != nil {
+			exitCode = strconv.Itoa(step.ExitCode)
+		}
+		table.Append([]string{
+			step.Name,
+			strings.Join(step.DependsOn, ", "),
+			step.JobName,
+			step.Status,
+			exitCode,
+			formatTime(step.StartedAt),
+			formatTime(step.FinishedAt),
+			step.Error,
+		})
+	}
+
+	table.Render()
+
+	return nil
+}
@@ -13,6 +13,18 @@ type YAML struct{}
 
 // Print marshals input data to YAML format and writes it to a given writer.
 func (p *YAML) Print(in JobDefinition, w io.Writer) error {
+	return p.print(in, w)
+}
+
+// PrintList marshals input data to YAML list and writes it to a given writer.
+func (p *YAML) PrintList(in []JobDefinition, w io.Writer) error {
+	if in == nil {
+		in = []JobDefinition{} // print empty list instead of null
+	}
+	return p.print(in, w)
+}
+
+func (p *YAML) print(in interface{}, w io.Writer) error {
 	out, err := yaml.Marshal(in)
 	if err != nil {
 		return err
@@ -0,0 +1,92 @@
+package daemon
+
+import (
+	"context"
+	"io"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+	"github.com/mszostok/job-runner/pkg/job"
+)
+
+// Attach streams the Job's output, and forwards stdin data and terminal resize events received from the client.
+func (h *Handler) Attach(gstream grpc.JobService_AttachServer) error {
+	req, err := gstream.Recv()
+	if err != nil {
+		return TranslateError(err)
+	}
+
+	// canceled when the output is no longer streamed, so forwarding input is stopped too
+	ctx, cancel := context.WithCancel(gstream.Context())
+	defer cancel()
+
+	id, err := h.resolveJob(ctx, req.Name)
+	if err != nil {
+		return TranslateError(err)
+	}
+
+	out, err := h.svc.Attach(ctx, job.AttachInput{ID: id})
+	if err != nil {
+		return TranslateError(err)
+	}
+
+	// the client needs to know whether to switch its terminal to raw mode before any output is produced
+	if err := gstream.Send(&grpc.AttachResponse{Tty: out.TTY}); err != nil {
+		return TranslateError(err)
+	}
+
+	go forwardAttachInput(ctx, gstream, out, req)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case chunk, ok := <-out.Output:
+			if !ok {
+				return nil // output closed, Job finished
+			}
+
+			err := gstream.Send(&grpc.AttachResponse{
+				Output: chunk.Data,
+				Stream: mapToGRPCLogStream(chunk.Stream),
+				Tty:    out.TTY,
+			})
+			if err != nil {
+				return TranslateError(err)
+			}
+		case err := <-out.Error:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return TranslateError(err)
+		}
+	}
+}
+
+// forwardAttachInput applies a given request and all following ones until the client closes sending,
+// or the stream is finished.
+func forwardAttachInput(ctx context.Context, gstream grpc.JobService_AttachServer, out *job.AttachOutput, req *grpc.AttachRequest) {
+	stdin := out.Stdin
+	for {
+		if len(req.Stdin) > 0 && stdin != nil {
+			if _, err := stdin.Write(req.Stdin); err != nil {
+				stdin = nil // Job has finished, its output is still streamed until the end
+			}
+		}
+		if req.CloseStdin && stdin != nil {
+			_ = stdin.Close()
+			stdin = nil
+		}
+		if req.Resize != nil {
+			// TODO(simplification): handle error, e.g. log it (zap/logrus)
+			_ = out.Resize(uint16(req.Resize.Rows), uint16(req.Resize.Cols))
+		}
+
+		var err error
+		req, err = gstream.Recv()
+		if err != nil || ctx.Err() != nil {
+			return
+		}
+	}
+}
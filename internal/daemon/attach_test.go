@@ -0,0 +1,148 @@
+package daemon_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mszostok/job-runner/internal/auth"
+	"github.com/mszostok/job-runner/internal/daemon"
+	"github.com/mszostok/job-runner/internal/daemon/automock"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+	"github.com/mszostok/job-runner/pkg/file"
+	"github.com/mszostok/job-runner/pkg/job"
+	"github.com/mszostok/job-runner/pkg/job/repo"
+)
+
+func TestHandler_Attach(t *testing.T) {
+	// given
+	serviceMock := &automock.JobService{}
+	resolverMock := &automock.JobResolver{}
+	handler := daemon.NewHandler(serviceMock, resolverMock)
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+	stream := &fakeAttachServer{
+		ctx: ctx,
+		requests: []*grpc.AttachRequest{
+			{Name: "episode-42", Resize: &grpc.TerminalSize{Rows: 24, Cols: 80}},
+			{Stdin: []byte("ls\r")},
+			{CloseStdin: true},
+		},
+	}
+
+	resolverMock.EXPECT().Resolve(repo.ResolveInput{ID: "episode-42", Tenant: user.Name, Name: "episode-42"}).
+		Return(repo.ResolveOutput{ID: jobID, Tenant: user.Name, Name: "episode-42"}, nil).Once()
+
+	stdin := &fakeStdin{closed: make(chan struct{})}
+	var resized [][2]uint16
+	output := make(chan file.Chunk, 1)
+	output <- file.Chunk{Stream: file.Stdout, Data: []byte("$ ")}
+	go func() {
+		<-stdin.closed // all requests were handled
+		close(output)
+	}()
+
+	serviceMock.EXPECT().Attach(mock.Anything, job.AttachInput{ID: jobID}).Return(&job.AttachOutput{
+		TTY:   true,
+		Stdin: stdin,
+		Resize: func(rows, cols uint16) error {
+			resized = append(resized, [2]uint16{rows, cols})
+			return nil
+		},
+		Output: output,
+		Error:  make(chan error),
+	}, nil).Once()
+
+	// when
+	err := handler.Attach(stream)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []*grpc.AttachResponse{
+		{Tty: true},
+		{Output: []byte("$ "), Stream: grpc.LogStream_STDOUT, Tty: true},
+	}, stream.sent)
+	assert.Equal(t, "ls\r", stdin.String())
+	assert.Equal(t, [][2]uint16{{24, 80}}, resized)
+
+	serviceMock.AssertExpectations(t)
+	resolverMock.AssertExpectations(t)
+}
+
+func TestHandler_Attach_NotRunning(t *testing.T) {
+	// given
+	serviceMock := &automock.JobService{}
+	resolverMock := &automock.JobResolver{}
+	handler := daemon.NewHandler(serviceMock, resolverMock)
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+	stream := &fakeAttachServer{ctx: ctx, requests: []*grpc.AttachRequest{{Name: "episode-42"}}}
+
+	resolverMock.EXPECT().Resolve(repo.ResolveInput{ID: "episode-42", Tenant: user.Name, Name: "episode-42"}).
+		Return(repo.ResolveOutput{ID: jobID, Tenant: user.Name, Name: "episode-42"}, nil).Once()
+	serviceMock.EXPECT().Attach(mock.Anything, job.AttachInput{ID: jobID}).
+		Return(nil, job.NewNotRunningError("episode-42", job.Succeeded)).Once()
+
+	// when
+	err := handler.Attach(stream)
+
+	// then
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Empty(t, stream.sent)
+
+	serviceMock.AssertExpectations(t)
+	resolverMock.AssertExpectations(t)
+}
+
+// fakeAttachServer returns pre-defined requests, and then behaves as if the client closed sending.
+type fakeAttachServer struct {
+	grpc.JobService_AttachServer
+
+	ctx      context.Context
+	requests []*grpc.AttachRequest
+	sent     []*grpc.AttachResponse
+}
+
+func (f *fakeAttachServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeAttachServer) Recv() (*grpc.AttachRequest, error) {
+	if len(f.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := f.requests[0]
+	f.requests = f.requests[1:]
+	return req, nil
+}
+
+func (f *fakeAttachServer) Send(resp *grpc.AttachResponse) error {
+	f.sent = append(f.sent, resp)
+	return nil
+}
+
+type fakeStdin struct {
+	bytes.Buffer
+	closed chan struct{}
+}
+
+func (f *fakeStdin) Close() error {
+	close(f.closed)
+	return nil
+}
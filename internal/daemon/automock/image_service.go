@@ -0,0 +1,259 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	image "github.com/mszostok/job-runner/pkg/image"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ImageService is an autogenerated mock type for the ImageService type
+type ImageService struct {
+	mock.Mock
+}
+
+type ImageService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ImageService) EXPECT() *ImageService_Expecter {
+	return &ImageService_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *ImageService) Delete(_a0 context.Context, _a1 image.DeleteInput) (*image.DeleteOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *image.DeleteOutput
+	if rf, ok := ret.Get(0).(func(context.Context, image.DeleteInput) *image.DeleteOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*image.DeleteOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, image.DeleteInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImageService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ImageService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 image.DeleteInput
+func (_e *ImageService_Expecter) Delete(_a0 interface{}, _a1 interface{}) *ImageService_Delete_Call {
+	return &ImageService_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1)}
+}
+
+func (_c *ImageService_Delete_Call) Run(run func(_a0 context.Context, _a1 image.DeleteInput)) *ImageService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(image.DeleteInput))
+	})
+	return _c
+}
+
+func (_c *ImageService_Delete_Call) Return(_a0 *image.DeleteOutput, _a1 error) *ImageService_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *ImageService) Get(_a0 context.Context, _a1 image.GetInput) (*image.GetOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *image.GetOutput
+	if rf, ok := ret.Get(0).(func(context.Context, image.GetInput) *image.GetOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*image.GetOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, image.GetInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImageService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ImageService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 image.GetInput
+func (_e *ImageService_Expecter) Get(_a0 interface{}, _a1 interface{}) *ImageService_Get_Call {
+	return &ImageService_Get_Call{Call: _e.mock.On("Get", _a0, _a1)}
+}
+
+func (_c *ImageService_Get_Call) Run(run func(_a0 context.Context, _a1 image.GetInput)) *ImageService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(image.GetInput))
+	})
+	return _c
+}
+
+func (_c *ImageService_Get_Call) Return(_a0 *image.GetOutput, _a1 error) *ImageService_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Import provides a mock function with given fields: _a0, _a1
+func (_m *ImageService) Import(_a0 context.Context, _a1 image.ImportInput) (*image.ImportOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *image.ImportOutput
+	if rf, ok := ret.Get(0).(func(context.Context, image.ImportInput) *image.ImportOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*image.ImportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, image.ImportInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImageService_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type ImageService_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 image.ImportInput
+func (_e *ImageService_Expecter) Import(_a0 interface{}, _a1 interface{}) *ImageService_Import_Call {
+	return &ImageService_Import_Call{Call: _e.mock.On("Import", _a0, _a1)}
+}
+
+func (_c *ImageService_Import_Call) Run(run func(_a0 context.Context, _a1 image.ImportInput)) *ImageService_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(image.ImportInput))
+	})
+	return _c
+}
+
+func (_c *ImageService_Import_Call) Return(_a0 *image.ImportOutput, _a1 error) *ImageService_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// List provides a mock function with given fields: _a0, _a1
+func (_m *ImageService) List(_a0 context.Context, _a1 image.ListInput) (*image.ListOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *image.ListOutput
+	if rf, ok := ret.Get(0).(func(context.Context, image.ListInput) *image.ListOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*image.ListOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, image.ListInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImageService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ImageService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 image.ListInput
+func (_e *ImageService_Expecter) List(_a0 interface{}, _a1 interface{}) *ImageService_List_Call {
+	return &ImageService_List_Call{Call: _e.mock.On("List", _a0, _a1)}
+}
+
+func (_c *ImageService_List_Call) Run(run func(_a0 context.Context, _a1 image.ListInput)) *ImageService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(image.ListInput))
+	})
+	return _c
+}
+
+func (_c *ImageService_List_Call) Return(_a0 *image.ListOutput, _a1 error) *ImageService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Register provides a mock function with given fields: _a0, _a1
+func (_m *ImageService) Register(_a0 context.Context, _a1 image.RegisterInput) (*image.RegisterOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *image.RegisterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, image.RegisterInput) *image.RegisterOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*image.RegisterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, image.RegisterInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImageService_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type ImageService_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 image.RegisterInput
+func (_e *ImageService_Expecter) Register(_a0 interface{}, _a1 interface{}) *ImageService_Register_Call {
+	return &ImageService_Register_Call{Call: _e.mock.On("Register", _a0, _a1)}
+}
+
+func (_c *ImageService_Register_Call) Run(run func(_a0 context.Context, _a1 image.RegisterInput)) *ImageService_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(image.RegisterInput))
+	})
+	return _c
+}
+
+func (_c *ImageService_Register_Call) Return(_a0 *image.RegisterOutput, _a1 error) *ImageService_Register_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
@@ -0,0 +1,65 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package automock
+
+import (
+	repo "github.com/mszostok/job-runner/pkg/job/repo"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobResolver is an autogenerated mock type for the JobResolver type
+type JobResolver struct {
+	mock.Mock
+}
+
+type JobResolver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobResolver) EXPECT() *JobResolver_Expecter {
+	return &JobResolver_Expecter{mock: &_m.Mock}
+}
+
+// Resolve provides a mock function with given fields: in
+func (_m *JobResolver) Resolve(in repo.ResolveInput) (repo.ResolveOutput, error) {
+	ret := _m.Called(in)
+
+	var r0 repo.ResolveOutput
+	if rf, ok := ret.Get(0).(func(repo.ResolveInput) repo.ResolveOutput); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(repo.ResolveOutput)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(repo.ResolveInput) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobResolver_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type JobResolver_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//  - in repo.ResolveInput
+func (_e *JobResolver_Expecter) Resolve(in interface{}) *JobResolver_Resolve_Call {
+	return &JobResolver_Resolve_Call{Call: _e.mock.On("Resolve", in)}
+}
+
+func (_c *JobResolver_Resolve_Call) Run(run func(in repo.ResolveInput)) *JobResolver_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(repo.ResolveInput))
+	})
+	return _c
+}
+
+func (_c *JobResolver_Resolve_Call) Return(_a0 repo.ResolveOutput, _a1 error) *JobResolver_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
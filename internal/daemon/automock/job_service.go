@@ -23,6 +23,100 @@ func (_m *JobService) EXPECT() *JobService_Expecter {
 	return &JobService_Expecter{mock: &_m.Mock}
 }
 
+// Attach provides a mock function with given fields: _a0, _a1
+func (_m *JobService) Attach(_a0 context.Context, _a1 job.AttachInput) (*job.AttachOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *job.AttachOutput
+	if rf, ok := ret.Get(0).(func(context.Context, job.AttachInput) *job.AttachOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*job.AttachOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, job.AttachInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_Attach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attach'
+type JobService_Attach_Call struct {
+	*mock.Call
+}
+
+// Attach is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 job.AttachInput
+func (_e *JobService_Expecter) Attach(_a0 interface{}, _a1 interface{}) *JobService_Attach_Call {
+	return &JobService_Attach_Call{Call: _e.mock.On("Attach", _a0, _a1)}
+}
+
+func (_c *JobService_Attach_Call) Run(run func(_a0 context.Context, _a1 job.AttachInput)) *JobService_Attach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.AttachInput))
+	})
+	return _c
+}
+
+func (_c *JobService_Attach_Call) Return(_a0 *job.AttachOutput, _a1 error) *JobService_Attach_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *JobService) Delete(_a0 context.Context, _a1 job.DeleteInput) (*job.DeleteOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *job.DeleteOutput
+	if rf, ok := ret.Get(0).(func(context.Context, job.DeleteInput) *job.DeleteOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*job.DeleteOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, job.DeleteInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type JobService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 job.DeleteInput
+func (_e *JobService_Expecter) Delete(_a0 interface{}, _a1 interface{}) *JobService_Delete_Call {
+	return &JobService_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1)}
+}
+
+func (_c *JobService_Delete_Call) Run(run func(_a0 context.Context, _a1 job.DeleteInput)) *JobService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.DeleteInput))
+	})
+	return _c
+}
+
+func (_c *JobService_Delete_Call) Return(_a0 *job.DeleteOutput, _a1 error) *JobService_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Get provides a mock function with given fields: _a0, _a1
 func (_m *JobService) Get(_a0 context.Context, _a1 job.GetInput) (*job.GetOutput, error) {
 	ret := _m.Called(_a0, _a1)
@@ -70,6 +164,100 @@ func (_c *JobService_Get_Call) Return(_a0 *job.GetOutput, _a1 error) *JobService
 	return _c
 }
 
+// GetStats provides a mock function with given fields: _a0, _a1
+func (_m *JobService) GetStats(_a0 context.Context, _a1 job.GetStatsInput) (*job.GetStatsOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *job.GetStatsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, job.GetStatsInput) *job.GetStatsOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*job.GetStatsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, job.GetStatsInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_GetStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStats'
+type JobService_GetStats_Call struct {
+	*mock.Call
+}
+
+// GetStats is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 job.GetStatsInput
+func (_e *JobService_Expecter) GetStats(_a0 interface{}, _a1 interface{}) *JobService_GetStats_Call {
+	return &JobService_GetStats_Call{Call: _e.mock.On("GetStats", _a0, _a1)}
+}
+
+func (_c *JobService_GetStats_Call) Run(run func(_a0 context.Context, _a1 job.GetStatsInput)) *JobService_GetStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.GetStatsInput))
+	})
+	return _c
+}
+
+func (_c *JobService_GetStats_Call) Return(_a0 *job.GetStatsOutput, _a1 error) *JobService_GetStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// List provides a mock function with given fields: _a0, _a1
+func (_m *JobService) List(_a0 context.Context, _a1 job.ListInput) (*job.ListOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *job.ListOutput
+	if rf, ok := ret.Get(0).(func(context.Context, job.ListInput) *job.ListOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*job.ListOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, job.ListInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type JobService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 job.ListInput
+func (_e *JobService_Expecter) List(_a0 interface{}, _a1 interface{}) *JobService_List_Call {
+	return &JobService_List_Call{Call: _e.mock.On("List", _a0, _a1)}
+}
+
+func (_c *JobService_List_Call) Run(run func(_a0 context.Context, _a1 job.ListInput)) *JobService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.ListInput))
+	})
+	return _c
+}
+
+func (_c *JobService_List_Call) Return(_a0 *job.ListOutput, _a1 error) *JobService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Run provides a mock function with given fields: _a0, _a1
 func (_m *JobService) Run(_a0 context.Context, _a1 job.RunInput) (*job.RunOutput, error) {
 	ret := _m.Called(_a0, _a1)
@@ -117,6 +305,53 @@ func (_c *JobService_Run_Call) Return(_a0 *job.RunOutput, _a1 error) *JobService
 	return _c
 }
 
+// Signal provides a mock function with given fields: _a0, _a1
+func (_m *JobService) Signal(_a0 context.Context, _a1 job.SignalInput) (*job.SignalOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *job.SignalOutput
+	if rf, ok := ret.Get(0).(func(context.Context, job.SignalInput) *job.SignalOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*job.SignalOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, job.SignalInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobService_Signal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Signal'
+type JobService_Signal_Call struct {
+	*mock.Call
+}
+
+// Signal is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 job.SignalInput
+func (_e *JobService_Expecter) Signal(_a0 interface{}, _a1 interface{}) *JobService_Signal_Call {
+	return &JobService_Signal_Call{Call: _e.mock.On("Signal", _a0, _a1)}
+}
+
+func (_c *JobService_Signal_Call) Run(run func(_a0 context.Context, _a1 job.SignalInput)) *JobService_Signal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.SignalInput))
+	})
+	return _c
+}
+
+func (_c *JobService_Signal_Call) Return(_a0 *job.SignalOutput, _a1 error) *JobService_Signal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Stop provides a mock function with given fields: _a0, _a1
 func (_m *JobService) Stop(_a0 context.Context, _a1 job.StopInput) (*job.StopOutput, error) {
 	ret := _m.Called(_a0, _a1)
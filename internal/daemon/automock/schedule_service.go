@@ -0,0 +1,165 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	schedule "github.com/mszostok/job-runner/pkg/schedule"
+)
+
+// ScheduleService is an autogenerated mock type for the ScheduleService type
+type ScheduleService struct {
+	mock.Mock
+}
+
+type ScheduleService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ScheduleService) EXPECT() *ScheduleService_Expecter {
+	return &ScheduleService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: _a0, _a1
+func (_m *ScheduleService) Create(_a0 context.Context, _a1 schedule.CreateInput) (*schedule.CreateOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *schedule.CreateOutput
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.CreateInput) *schedule.CreateOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schedule.CreateOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, schedule.CreateInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ScheduleService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 schedule.CreateInput
+func (_e *ScheduleService_Expecter) Create(_a0 interface{}, _a1 interface{}) *ScheduleService_Create_Call {
+	return &ScheduleService_Create_Call{Call: _e.mock.On("Create", _a0, _a1)}
+}
+
+func (_c *ScheduleService_Create_Call) Run(run func(_a0 context.Context, _a1 schedule.CreateInput)) *ScheduleService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.CreateInput))
+	})
+	return _c
+}
+
+func (_c *ScheduleService_Create_Call) Return(_a0 *schedule.CreateOutput, _a1 error) *ScheduleService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *ScheduleService) Delete(_a0 context.Context, _a1 schedule.DeleteInput) (*schedule.DeleteOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *schedule.DeleteOutput
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.DeleteInput) *schedule.DeleteOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schedule.DeleteOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, schedule.DeleteInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ScheduleService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 schedule.DeleteInput
+func (_e *ScheduleService_Expecter) Delete(_a0 interface{}, _a1 interface{}) *ScheduleService_Delete_Call {
+	return &ScheduleService_Delete_Call{Call: _e.mock.On("Delete", _a0, _a1)}
+}
+
+func (_c *ScheduleService_Delete_Call) Run(run func(_a0 context.Context, _a1 schedule.DeleteInput)) *ScheduleService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.DeleteInput))
+	})
+	return _c
+}
+
+func (_c *ScheduleService_Delete_Call) Return(_a0 *schedule.DeleteOutput, _a1 error) *ScheduleService_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// List provides a mock function with given fields: _a0, _a1
+func (_m *ScheduleService) List(_a0 context.Context, _a1 schedule.ListInput) (*schedule.ListOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *schedule.ListOutput
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.ListInput) *schedule.ListOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schedule.ListOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, schedule.ListInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleService_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ScheduleService_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 schedule.ListInput
+func (_e *ScheduleService_Expecter) List(_a0 interface{}, _a1 interface{}) *ScheduleService_List_Call {
+	return &ScheduleService_List_Call{Call: _e.mock.On("List", _a0, _a1)}
+}
+
+func (_c *ScheduleService_List_Call) Run(run func(_a0 context.Context, _a1 schedule.ListInput)) *ScheduleService_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.ListInput))
+	})
+	return _c
+}
+
+func (_c *ScheduleService_List_Call) Return(_a0 *schedule.ListOutput, _a1 error) *ScheduleService_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
@@ -0,0 +1,118 @@
+// Code generated by mockery v2.10.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	workflow "github.com/mszostok/job-runner/pkg/workflow"
+)
+
+// WorkflowService is an autogenerated mock type for the WorkflowService type
+type WorkflowService struct {
+	mock.Mock
+}
+
+type WorkflowService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WorkflowService) EXPECT() *WorkflowService_Expecter {
+	return &WorkflowService_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *WorkflowService) Get(_a0 context.Context, _a1 workflow.GetInput) (*workflow.GetOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *workflow.GetOutput
+	if rf, ok := ret.Get(0).(func(context.Context, workflow.GetInput) *workflow.GetOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.GetOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, workflow.GetInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkflowService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type WorkflowService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 workflow.GetInput
+func (_e *WorkflowService_Expecter) Get(_a0 interface{}, _a1 interface{}) *WorkflowService_Get_Call {
+	return &WorkflowService_Get_Call{Call: _e.mock.On("Get", _a0, _a1)}
+}
+
+func (_c *WorkflowService_Get_Call) Run(run func(_a0 context.Context, _a1 workflow.GetInput)) *WorkflowService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(workflow.GetInput))
+	})
+	return _c
+}
+
+func (_c *WorkflowService_Get_Call) Return(_a0 *workflow.GetOutput, _a1 error) *WorkflowService_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Submit provides a mock function with given fields: _a0, _a1
+func (_m *WorkflowService) Submit(_a0 context.Context, _a1 workflow.SubmitInput) (*workflow.SubmitOutput, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *workflow.SubmitOutput
+	if rf, ok := ret.Get(0).(func(context.Context, workflow.SubmitInput) *workflow.SubmitOutput); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflow.SubmitOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, workflow.SubmitInput) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkflowService_Submit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Submit'
+type WorkflowService_Submit_Call struct {
+	*mock.Call
+}
+
+// Submit is a helper method to define mock.On call
+//  - _a0 context.Context
+//  - _a1 workflow.SubmitInput
+func (_e *WorkflowService_Expecter) Submit(_a0 interface{}, _a1 interface{}) *WorkflowService_Submit_Call {
+	return &WorkflowService_Submit_Call{Call: _e.mock.On("Submit", _a0, _a1)}
+}
+
+func (_c *WorkflowService_Submit_Call) Run(run func(_a0 context.Context, _a1 workflow.SubmitInput)) *WorkflowService_Submit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(workflow.SubmitInput))
+	})
+	return _c
+}
+
+func (_c *WorkflowService_Submit_Call) Return(_a0 *workflow.SubmitOutput, _a1 error) *WorkflowService_Submit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
@@ -27,6 +27,12 @@ func TranslateError(err error) error {
 		return status.Error(codes.AlreadyExists, err.Error())
 	case job.IsNotFoundError(err):
 		return status.Error(codes.NotFound, err.Error())
+	case job.IsInvalidInputError(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case job.IsFailedPreconditionError(err):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case job.IsPermissionDeniedError(err):
+		return status.Error(codes.PermissionDenied, err.Error())
 	}
 
 	return status.Error(codes.Internal, err.Error())
@@ -0,0 +1,142 @@
+package daemon
+
+import (
+	"context"
+
+	"github.com/mszostok/job-runner/internal/auth"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+	"github.com/mszostok/job-runner/pkg/image"
+)
+
+// ImageService provides functionality to manage root filesystem images.
+//go:generate mockery --name=ImageService --output=automock --outpkg=automock --case=underscore --with-expecter
+type ImageService interface {
+	Import(context.Context, image.ImportInput) (*image.ImportOutput, error)
+	Register(context.Context, image.RegisterInput) (*image.RegisterOutput, error)
+	Get(context.Context, image.GetInput) (*image.GetOutput, error)
+	List(context.Context, image.ListInput) (*image.ListOutput, error)
+	Delete(context.Context, image.DeleteInput) (*image.DeleteOutput, error)
+}
+
+// ImportImage imports image from the archive streamed in chunks, or registers a directory from the Agent's host.
+// Only admin may register host directories.
+func (h *Handler) ImportImage(gstream grpc.JobService_ImportImageServer) error {
+	if h.images == nil {
+		return h.UnimplementedJobServiceServer.ImportImage(gstream)
+	}
+
+	req, err := gstream.Recv()
+	if err != nil {
+		return TranslateError(err)
+	}
+
+	ctx := gstream.Context()
+	user, err := auth.FromContext(ctx)
+	if err != nil {
+		return TranslateError(err)
+	}
+
+	if req.HostPath != "" {
+		if !user.IsAdmin() {
+			return auth.NewGRPCPermissionDeniedError()
+		}
+		_, err := h.images.Register(ctx, image.RegisterInput{
+			Tenant:   user.Name,
+			Name:     req.Name,
+			HostPath: req.HostPath,
+		})
+		if err != nil {
+			return TranslateError(err)
+		}
+		return gstream.SendAndClose(&grpc.ImportImageResponse{})
+	}
+
+	_, err = h.images.Import(ctx, image.ImportInput{
+		Tenant:  user.Name,
+		Name:    req.Name,
+		Archive: &chunkReader{gstream: gstream, chunk: req.Chunk},
+	})
+	if err != nil {
+		return TranslateError(err)
+	}
+	return gstream.SendAndClose(&grpc.ImportImageResponse{})
+}
+
+func (h *Handler) ListImages(ctx context.Context, req *grpc.ListImagesRequest) (*grpc.ListImagesResponse, error) {
+	if req == nil {
+		return nil, NilRequestInputError
+	}
+	if h.images == nil {
+		return h.UnimplementedJobServiceServer.ListImages(ctx, req)
+	}
+
+	if _, err := auth.FromContext(ctx); err != nil {
+		return nil, TranslateError(err)
+	}
+
+	out, err := h.images.List(ctx, image.ListInput{})
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	// images are shared, so all tenants can see and use them
+	resp := &grpc.ListImagesResponse{
+		Images: make([]*grpc.ImageSummary, 0, len(out.Images)),
+	}
+	for _, img := range out.Images {
+		resp.Images = append(resp.Images, &grpc.ImageSummary{
+			Name:      img.Name,
+			CreatedBy: img.Tenant,
+			CreatedAt: mapToGRPCTime(img.CreatedAt),
+			HostPath:  img.HostPath,
+		})
+	}
+	return resp, nil
+}
+
+func (h *Handler) DeleteImage(ctx context.Context, req *grpc.DeleteImageRequest) (*grpc.DeleteImageResponse, error) {
+	if req == nil {
+		return nil, NilRequestInputError
+	}
+	if h.images == nil {
+		return h.UnimplementedJobServiceServer.DeleteImage(ctx, req)
+	}
+
+	user, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	out, err := h.images.Get(ctx, image.GetInput{Name: req.Name})
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+	if err := user.CheckAuthorized(out.Image.Tenant); err != nil {
+		return nil, TranslateError(err)
+	}
+
+	if _, err := h.images.Delete(ctx, image.DeleteInput{Name: req.Name}); err != nil {
+		return nil, TranslateError(err)
+	}
+	return &grpc.DeleteImageResponse{}, nil
+}
+
+// chunkReader reads archive chunks received from the ImportImage stream until the client closes sending.
+type chunkReader struct {
+	gstream grpc.JobService_ImportImageServer
+	chunk   []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.gstream.Recv()
+		if err != nil {
+			return 0, err // io.EOF when the client closes sending
+		}
+		r.chunk = req.Chunk
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
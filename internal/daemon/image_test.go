@@ -0,0 +1,157 @@
+package daemon_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mszostok/job-runner/internal/auth"
+	"github.com/mszostok/job-runner/internal/daemon"
+	"github.com/mszostok/job-runner/internal/daemon/automock"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+	"github.com/mszostok/job-runner/pkg/image"
+)
+
+func TestHandler_ImportImage(t *testing.T) {
+	// given
+	imageMock := &automock.ImageService{}
+	handler := daemon.NewHandler(&automock.JobService{}, &automock.JobResolver{}, daemon.WithImageService(imageMock))
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+	stream := &fakeImportImageServer{
+		ctx: ctx,
+		requests: []*grpc.ImportImageRequest{
+			{Name: "tools", Chunk: []byte("first ")},
+			{Chunk: []byte("second")},
+		},
+	}
+
+	var archive []byte
+	imageMock.EXPECT().Import(ctx, mock.Anything).Run(func(_ context.Context, in image.ImportInput) {
+		assert.Equal(t, user.Name, in.Tenant)
+		assert.Equal(t, "tools", in.Name)
+
+		var err error
+		archive, err = io.ReadAll(in.Archive)
+		assert.NoError(t, err)
+	}).Return(&image.ImportOutput{}, nil).Once()
+
+	// when
+	err := handler.ImportImage(stream)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "first second", string(archive))
+	assert.True(t, stream.closed)
+
+	imageMock.AssertExpectations(t)
+}
+
+func TestHandler_ImportImage_HostPath(t *testing.T) {
+	tests := map[string]struct {
+		roles   map[string]struct{}
+		expCode codes.Code
+	}{
+		"Should register host path for admin": {
+			roles:   map[string]struct{}{"admin": {}},
+			expCode: codes.OK,
+		},
+		"Should reject host path for user": {
+			roles:   map[string]struct{}{"user": {}},
+			expCode: codes.PermissionDenied,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			imageMock := &automock.ImageService{}
+			handler := daemon.NewHandler(&automock.JobService{}, &automock.JobResolver{}, daemon.WithImageService(imageMock))
+
+			user := auth.User{
+				Name:  "Ricky",
+				Roles: tc.roles,
+			}
+			ctx := auth.NewContext(context.Background(), &user)
+			stream := &fakeImportImageServer{
+				ctx:      ctx,
+				requests: []*grpc.ImportImageRequest{{Name: "debian", HostPath: "/srv/rootfs/debian"}},
+			}
+
+			if tc.expCode == codes.OK {
+				imageMock.EXPECT().Register(ctx, image.RegisterInput{
+					Tenant:   user.Name,
+					Name:     "debian",
+					HostPath: "/srv/rootfs/debian",
+				}).Return(&image.RegisterOutput{}, nil).Once()
+			}
+
+			// when
+			err := handler.ImportImage(stream)
+
+			// then
+			assert.Equal(t, tc.expCode, status.Code(err))
+			imageMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_DeleteImage_Unauthorized(t *testing.T) {
+	// given
+	imageMock := &automock.ImageService{}
+	handler := daemon.NewHandler(&automock.JobService{}, &automock.JobResolver{}, daemon.WithImageService(imageMock))
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+
+	imageMock.EXPECT().Get(ctx, image.GetInput{Name: "tools"}).
+		Return(&image.GetOutput{Image: image.Image{Name: "tools", Tenant: "Morty"}}, nil).Once()
+
+	// when
+	_, err := handler.DeleteImage(ctx, &grpc.DeleteImageRequest{Name: "tools"})
+
+	// then
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	imageMock.AssertExpectations(t)
+}
+
+// fakeImportImageServer returns pre-defined requests, and then behaves as if the client closed sending.
+type fakeImportImageServer struct {
+	grpc.JobService_ImportImageServer
+
+	ctx      context.Context
+	requests []*grpc.ImportImageRequest
+	closed   bool
+}
+
+func (f *fakeImportImageServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeImportImageServer) Recv() (*grpc.ImportImageRequest, error) {
+	if len(f.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := f.requests[0]
+	f.requests = f.requests[1:]
+	return req, nil
+}
+
+func (f *fakeImportImageServer) SendAndClose(*grpc.ImportImageResponse) error {
+	f.closed = true
+	return nil
+}
@@ -0,0 +1,156 @@
+package daemon
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mszostok/job-runner/internal/auth"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+	"github.com/mszostok/job-runner/pkg/job"
+	"github.com/mszostok/job-runner/pkg/schedule"
+)
+
+// ScheduleService provides functionality to manage ScheduledJobs.
+//go:generate mockery --name=ScheduleService --output=automock --outpkg=automock --case=underscore --with-expecter
+type ScheduleService interface {
+	Create(context.Context, schedule.CreateInput) (*schedule.CreateOutput, error)
+	List(context.Context, schedule.ListInput) (*schedule.ListOutput, error)
+	Delete(context.Context, schedule.DeleteInput) (*schedule.DeleteOutput, error)
+}
+
+func (h *Handler) CreateSchedule(ctx context.Context, req *grpc.CreateScheduleRequest) (*grpc.CreateScheduleResponse, error) {
+	if req == nil {
+		return nil, NilRequestInputError
+	}
+	if h.schedules == nil {
+		return h.UnimplementedJobServiceServer.CreateSchedule(ctx, req)
+	}
+	if req.JobTemplate == nil {
+		return nil, status.Error(codes.InvalidArgument, "Job template cannot be empty")
+	}
+
+	user, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	out, err := h.schedules.Create(ctx, schedule.CreateInput{
+		Tenant:            user.Name,
+		Name:              req.Name,
+		Cron:              req.Cron,
+		Template:          mapToJobTemplate(req.JobTemplate),
+		ConcurrencyPolicy: schedule.ConcurrencyPolicy(req.ConcurrencyPolicy.String()),
+		HistoryLimit:      int(req.HistoryLimit),
+	})
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	return &grpc.CreateScheduleResponse{
+		NextScheduleAt: mapToGRPCTime(out.NextScheduleAt),
+	}, nil
+}
+
+func (h *Handler) ListSchedules(ctx context.Context, req *grpc.ListSchedulesRequest) (*grpc.ListSchedulesResponse, error) {
+	if req == nil {
+		return nil, NilRequestInputError
+	}
+	if h.schedules == nil {
+		return h.UnimplementedJobServiceServer.ListSchedules(ctx, req)
+	}
+
+	user, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	// Only admin may list not owned ScheduledJobs. If tenant is not specified, user gets only owned ScheduledJobs.
+	tenant := req.Tenant
+	if tenant == "" && !user.IsAdmin() {
+		tenant = user.Name
+	}
+	if err := user.CheckAuthorized(tenantOrSelf(tenant, user)); err != nil {
+		return nil, TranslateError(err)
+	}
+
+	out, err := h.schedules.List(ctx, schedule.ListInput{Tenant: tenant})
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	resp := &grpc.ListSchedulesResponse{}
+	for _, item := range out.Schedules {
+		def := item.Schedule
+		resp.Schedules = append(resp.Schedules, &grpc.ScheduleSummary{
+			Name:              def.Name,
+			CreatedBy:         def.Tenant,
+			Cron:              def.Cron,
+			ConcurrencyPolicy: grpc.ConcurrencyPolicy(grpc.ConcurrencyPolicy_value[string(def.ConcurrencyPolicy)]),
+			HistoryLimit:      int32(def.HistoryLimit),
+			CreatedAt:         mapToGRPCTime(def.CreatedAt),
+			LastScheduleAt:    mapToGRPCTime(def.LastScheduleAt),
+			NextScheduleAt:    mapToGRPCTime(item.NextScheduleAt),
+			LastError:         def.LastError,
+			Jobs:              def.Jobs,
+		})
+	}
+	return resp, nil
+}
+
+func (h *Handler) DeleteSchedule(ctx context.Context, req *grpc.DeleteScheduleRequest) (*grpc.DeleteScheduleResponse, error) {
+	if req == nil {
+		return nil, NilRequestInputError
+	}
+	if h.schedules == nil {
+		return h.UnimplementedJobServiceServer.DeleteSchedule(ctx, req)
+	}
+
+	user, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	// ScheduledJob is resolved in the caller's namespace, unless namespaced name, e.g. "Ricky/nightly", is given
+	tenant, name, ok := job.SplitNamespacedName(req.Name)
+	if !ok {
+		tenant, name = user.Name, req.Name
+	}
+	// checked upfront, so names in not owned namespaces cannot be probed
+	if err := user.CheckAuthorized(tenant); err != nil {
+		return nil, TranslateError(err)
+	}
+
+	if _, err := h.schedules.Delete(ctx, schedule.DeleteInput{Tenant: tenant, Name: name}); err != nil {
+		return nil, TranslateError(err)
+	}
+	return &grpc.DeleteScheduleResponse{}, nil
+}
+
+func mapToJobTemplate(in *grpc.JobTemplate) job.Template {
+	out := job.Template{
+		Command:             in.Command,
+		Args:                in.Args,
+		Env:                 in.Env,
+		Resources:           mapToCgroupResources(in.Resources),
+		Restart:             mapToRestartOptions(in.Restart),
+		Priority:            int(in.Priority),
+		WorkingDir:          in.WorkingDir,
+		User:                in.User,
+		Group:               in.Group,
+		SupplementaryGroups: in.SupplementaryGroups,
+		Umask:               in.Umask,
+		Isolation:           mapToIsolation(in.Isolation),
+		Rootfs:              in.Rootfs,
+		ReadOnlyPaths:       in.ReadOnlyPaths,
+		SecurityProfile:     in.SecurityProfile,
+	}
+	if in.Timeout != nil {
+		out.Timeout = *in.Timeout
+	}
+	if in.TimeoutGracePeriod != nil {
+		out.TimeoutGracePeriod = *in.TimeoutGracePeriod
+	}
+	return out
+}
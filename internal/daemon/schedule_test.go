@@ -0,0 +1,130 @@
+package daemon_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mszostok/job-runner/internal/auth"
+	"github.com/mszostok/job-runner/internal/daemon"
+	"github.com/mszostok/job-runner/internal/daemon/automock"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+	"github.com/mszostok/job-runner/pkg/job"
+	"github.com/mszostok/job-runner/pkg/schedule"
+)
+
+func TestHandler_CreateSchedule(t *testing.T) {
+	// given
+	scheduleMock := &automock.ScheduleService{}
+	handler := daemon.NewHandler(&automock.JobService{}, &automock.JobResolver{}, daemon.WithScheduleService(scheduleMock))
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+
+	timeout := time.Hour
+	next := time.Date(2022, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	scheduleMock.EXPECT().Create(ctx, schedule.CreateInput{
+		Tenant: user.Name,
+		Name:   "nightly",
+		Cron:   "@daily",
+		Template: job.Template{
+			Command: "make",
+			Args:    []string{"e2e-test"},
+			Timeout: timeout,
+			Restart: job.RestartOptions{Policy: job.RestartOnFailure, MaxRestarts: 2},
+		},
+		ConcurrencyPolicy: schedule.ForbidConcurrent,
+		HistoryLimit:      5,
+	}).Return(&schedule.CreateOutput{NextScheduleAt: next}, nil).Once()
+
+	// when
+	out, err := handler.CreateSchedule(ctx, &grpc.CreateScheduleRequest{
+		Name: "nightly",
+		Cron: "@daily",
+		JobTemplate: &grpc.JobTemplate{
+			Command: "make",
+			Args:    []string{"e2e-test"},
+			Timeout: &timeout,
+			Restart: &grpc.RestartOptions{Policy: grpc.RestartPolicy_ON_FAILURE, MaxRestarts: 2},
+		},
+		ConcurrencyPolicy: grpc.ConcurrencyPolicy_FORBID,
+		HistoryLimit:      5,
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, &next, out.NextScheduleAt)
+
+	scheduleMock.AssertExpectations(t)
+}
+
+func TestHandler_DeleteSchedule_Unauthorized(t *testing.T) {
+	// given
+	scheduleMock := &automock.ScheduleService{}
+	handler := daemon.NewHandler(&automock.JobService{}, &automock.JobResolver{}, daemon.WithScheduleService(scheduleMock))
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+
+	// when
+	_, err := handler.DeleteSchedule(ctx, &grpc.DeleteScheduleRequest{Name: "Morty/nightly"})
+
+	// then
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+	scheduleMock.AssertExpectations(t)
+}
+
+func TestHandler_DeleteSchedule_CallerNamespace(t *testing.T) {
+	// given
+	scheduleMock := &automock.ScheduleService{}
+	handler := daemon.NewHandler(&automock.JobService{}, &automock.JobResolver{}, daemon.WithScheduleService(scheduleMock))
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+
+	scheduleMock.EXPECT().Delete(ctx, schedule.DeleteInput{Tenant: "Ricky", Name: "nightly"}).
+		Return(&schedule.DeleteOutput{}, nil).Once()
+
+	// when
+	_, err := handler.DeleteSchedule(ctx, &grpc.DeleteScheduleRequest{Name: "nightly"})
+
+	// then
+	require.NoError(t, err)
+
+	scheduleMock.AssertExpectations(t)
+}
+
+func TestHandler_Schedules_NotEnabled(t *testing.T) {
+	// given
+	handler := daemon.NewHandler(&automock.JobService{}, &automock.JobResolver{})
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+
+	// when
+	_, err := handler.ListSchedules(ctx, &grpc.ListSchedulesRequest{})
+
+	// then
+	require.Error(t, err)
+	assert.Equal(t, codes.Unimplemented, status.Convert(err).Code())
+}
@@ -3,47 +3,95 @@ package daemon
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/mszostok/job-runner/internal/auth"
 	"github.com/mszostok/job-runner/pkg/api/grpc"
+	"github.com/mszostok/job-runner/pkg/cgroup"
+	"github.com/mszostok/job-runner/pkg/file"
 	"github.com/mszostok/job-runner/pkg/job"
 	"github.com/mszostok/job-runner/pkg/job/repo"
 )
 
 var _ grpc.JobServiceServer = &Handler{}
 
+const (
+	defaultWatchStatsInterval = 2 * time.Second
+	minWatchStatsInterval     = 100 * time.Millisecond
+)
+
 // JobService provides full functionality to manged Jobs.
 //go:generate mockery --name=JobService --output=automock --outpkg=automock --case=underscore --with-expecter
 type JobService interface {
 	Run(context.Context, job.RunInput) (*job.RunOutput, error)
 	Get(context.Context, job.GetInput) (*job.GetOutput, error)
+	List(context.Context, job.ListInput) (*job.ListOutput, error)
 	Stop(context.Context, job.StopInput) (*job.StopOutput, error)
+	Signal(context.Context, job.SignalInput) (*job.SignalOutput, error)
+	Delete(context.Context, job.DeleteInput) (*job.DeleteOutput, error)
 	StreamLogs(context.Context, job.StreamLogsInput) (*job.StreamLogsOutput, error)
+	Attach(context.Context, job.AttachInput) (*job.AttachOutput, error)
+	GetStats(context.Context, job.GetStatsInput) (*job.GetStatsOutput, error)
 }
 
-// TenantGetter provides functionality to get Job's tenant information.
-//go:generate mockery --name=TenantGetter --output=automock --outpkg=automock --case=underscore --with-expecter
-type TenantGetter interface {
-	GetJobTenant(in repo.GetJobTenantInput) (repo.GetJobTenantOutput, error)
+// JobResolver provides functionality to resolve Job references, IDs and namespaced names, into Jobs.
+//go:generate mockery --name=JobResolver --output=automock --outpkg=automock --case=underscore --with-expecter
+type JobResolver interface {
+	Resolve(in repo.ResolveInput) (repo.ResolveOutput, error)
 }
 
 // Handler handles incoming requests to the Daemon gRPC server.
 type Handler struct {
 	grpc.UnimplementedJobServiceServer
 
-	svc          JobService
-	tenantGetter TenantGetter
+	svc      JobService
+	resolver JobResolver
+	// schedules is optional. If not set, ScheduledJobs related RPCs are not implemented.
+	schedules ScheduleService
+	// workflows is optional. If not set, Workflows related RPCs are not implemented.
+	workflows WorkflowService
+	// images is optional. If not set, images related RPCs are not implemented.
+	images ImageService
+}
+
+// HandlerOption provides an option to configure Handler instance.
+type HandlerOption func(h *Handler)
+
+// WithScheduleService enables ScheduledJobs related RPCs.
+func WithScheduleService(svc ScheduleService) HandlerOption {
+	return func(h *Handler) {
+		h.schedules = svc
+	}
+}
+
+// WithWorkflowService enables Workflows related RPCs.
+func WithWorkflowService(svc WorkflowService) HandlerOption {
+	return func(h *Handler) {
+		h.workflows = svc
+	}
+}
+
+// WithImageService enables images related RPCs.
+func WithImageService(svc ImageService) HandlerOption {
+	return func(h *Handler) {
+		h.images = svc
+	}
 }
 
 // NewHandler returns new Handler.
-func NewHandler(svc JobService, getter TenantGetter) *Handler {
-	return &Handler{
-		tenantGetter: getter,
-		svc:          svc,
+func NewHandler(svc JobService, resolver JobResolver, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		resolver: resolver,
+		svc:      svc,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 func (h *Handler) Run(ctx context.Context, req *grpc.RunRequest) (*grpc.RunResponse, error) {
@@ -56,18 +104,44 @@ func (h *Handler) Run(ctx context.Context, req *grpc.RunRequest) (*grpc.RunRespo
 		return nil, TranslateError(err)
 	}
 
-	_, err = h.svc.Run(ctx, job.RunInput{
-		Tenant:  user.Name,
-		Name:    req.Name,
-		Command: req.Command,
-		Args:    req.Args,
-		Env:     req.Env,
-	})
+	in := job.RunInput{
+		Tenant:              user.Name,
+		Name:                req.Name,
+		GenerateName:        req.GenerateName,
+		Command:             req.Command,
+		Args:                req.Args,
+		Env:                 req.Env,
+		Resources:           mapToCgroupResources(req.Resources),
+		Priority:            int(req.Priority),
+		WorkingDir:          req.WorkingDir,
+		User:                req.User,
+		Group:               req.Group,
+		SupplementaryGroups: req.SupplementaryGroups,
+		Umask:               req.Umask,
+		Stdin:               req.Stdin,
+		TTY:                 req.Tty,
+		Isolation:           mapToIsolation(req.Isolation),
+		Rootfs:              req.Rootfs,
+		ReadOnlyPaths:       req.ReadOnlyPaths,
+		SecurityProfile:     req.SecurityProfile,
+	}
+	if req.Timeout != nil {
+		in.Timeout = *req.Timeout
+	}
+	if req.TimeoutGracePeriod != nil {
+		in.TimeoutGracePeriod = *req.TimeoutGracePeriod
+	}
+	in.Restart = mapToRestartOptions(req.Restart)
+
+	out, err := h.svc.Run(ctx, in)
 	if err != nil {
 		return nil, TranslateError(err)
 	}
 
-	return &grpc.RunResponse{}, nil
+	return &grpc.RunResponse{
+		Id:   out.ID,
+		Name: out.Name,
+	}, nil
 }
 
 func (h *Handler) Get(ctx context.Context, req *grpc.GetRequest) (*grpc.GetResponse, error) {
@@ -75,65 +149,216 @@ func (h *Handler) Get(ctx context.Context, req *grpc.GetRequest) (*grpc.GetRespo
 		return nil, NilRequestInputError
 	}
 
-	if err := h.checkAuthorized(ctx, req.Name); err != nil {
+	id, err := h.resolveJob(ctx, req.Name)
+	if err != nil {
 		return nil, TranslateError(err)
 	}
 
 	out, err := h.svc.Get(ctx, job.GetInput{
-		Name: req.Name,
+		ID: id,
 	})
 	if err != nil {
 		return nil, TranslateError(err)
 	}
 
 	return &grpc.GetResponse{
-		CreatedBy: out.CreatedBy,
-		Status:    mapToGRPCStatus(out.Status),
-		ExitCode:  int32(out.ExitCode),
+		Id:                out.ID,
+		Name:              out.Name,
+		CreatedBy:         out.CreatedBy,
+		Pid:               int64(out.PID),
+		Status:            mapToGRPCStatus(out.Status),
+		ExitCode:          int32(out.ExitCode),
+		CreatedAt:         mapToGRPCTime(out.CreatedAt),
+		StartedAt:         mapToGRPCTime(out.StartedAt),
+		FinishedAt:        mapToGRPCTime(out.FinishedAt),
+		TerminationReason: mapToGRPCTerminationReason(out.TerminationReason),
+		Signal:            out.Signal,
+		StoppedBy:         out.StoppedBy,
+		Attempt:           int32(out.Attempt),
+		Restarts:          int32(out.Restarts),
+		Attempts:          mapToGRPCAttempts(out.Attempts),
+		QueuePosition:     int32(out.QueuePosition),
+		SecurityProfile:   out.SecurityProfile,
+		Error:             out.Error,
 	}, nil
 }
 
+func (h *Handler) List(ctx context.Context, req *grpc.ListRequest) (*grpc.ListResponse, error) {
+	if req == nil {
+		return nil, NilRequestInputError
+	}
+
+	user, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size cannot be negative")
+	}
+
+	// Only admin may list not owned Jobs. If tenant is not specified, user gets only owned Jobs.
+	tenant := req.Tenant
+	if tenant == "" && !user.IsAdmin() {
+		tenant = user.Name
+	}
+	if err := user.CheckAuthorized(tenantOrSelf(tenant, user)); err != nil {
+		return nil, TranslateError(err)
+	}
+
+	statuses := make([]job.Status, 0, len(req.Statuses))
+	for _, s := range req.Statuses {
+		statuses = append(statuses, job.Status(s.String()))
+	}
+
+	out, err := h.svc.List(ctx, job.ListInput{
+		Tenant:     tenant,
+		Statuses:   statuses,
+		NamePrefix: req.NamePrefix,
+		PageSize:   int(req.PageSize),
+		PageToken:  req.PageToken,
+	})
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	resp := &grpc.ListResponse{
+		Jobs:          make([]*grpc.JobSummary, 0, len(out.Jobs)),
+		NextPageToken: out.NextPageToken,
+	}
+	for _, item := range out.Jobs {
+		resp.Jobs = append(resp.Jobs, &grpc.JobSummary{
+			Id:                item.ID,
+			Name:              item.Name,
+			CreatedBy:         item.CreatedBy,
+			Status:            mapToGRPCStatus(item.Status),
+			ExitCode:          int32(item.ExitCode),
+			CreatedAt:         mapToGRPCTime(item.CreatedAt),
+			StartedAt:         mapToGRPCTime(item.StartedAt),
+			FinishedAt:        mapToGRPCTime(item.FinishedAt),
+			TerminationReason: mapToGRPCTerminationReason(item.TerminationReason),
+			Signal:            item.Signal,
+			StoppedBy:         item.StoppedBy,
+			Restarts:          int32(item.Restarts),
+			QueuePosition:     int32(item.QueuePosition),
+		})
+	}
+	return resp, nil
+}
+
 func (h *Handler) Stop(ctx context.Context, req *grpc.StopRequest) (*grpc.StopResponse, error) {
 	if req == nil {
 		return nil, NilRequestInputError
 	}
 
-	if err := h.checkAuthorized(ctx, req.Name); err != nil {
+	id, err := h.resolveJob(ctx, req.Name)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	user, err := auth.FromContext(ctx)
+	if err != nil {
 		return nil, TranslateError(err)
 	}
 
 	stop := job.StopInput{
-		Name: req.Name,
+		ID:        id,
+		StoppedBy: user.Name,
 	}
 	if req.GracePeriod != nil {
 		stop.GracePeriod = *req.GracePeriod
 	}
+	if req.StopSignal != "" {
+		if stop.Signal, err = job.ParseSignal(req.StopSignal); err != nil {
+			return nil, TranslateError(err)
+		}
+	}
 	out, err := h.svc.Stop(ctx, stop)
 	if err != nil {
 		return nil, TranslateError(err)
 	}
 
 	return &grpc.StopResponse{
-		Status:   mapToGRPCStatus(out.Status),
-		ExitCode: int32(out.ExitCode),
+		Status:            mapToGRPCStatus(out.Status),
+		ExitCode:          int32(out.ExitCode),
+		TerminationReason: mapToGRPCTerminationReason(out.TerminationReason),
+		Signal:            out.Signal,
 	}, nil
 }
 
+// Signal sends a given signal to all processes of a running Job.
+func (h *Handler) Signal(ctx context.Context, req *grpc.SignalRequest) (*grpc.SignalResponse, error) {
+	if req == nil {
+		return nil, NilRequestInputError
+	}
+
+	id, err := h.resolveJob(ctx, req.Name)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	sig, err := job.ParseSignal(req.Signal)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	_, err = h.svc.Signal(ctx, job.SignalInput{
+		ID:     id,
+		Signal: sig,
+	})
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+	return &grpc.SignalResponse{}, nil
+}
+
+// Delete removes a given finished Job together with its logs, so its name can be reused within the tenant namespace.
+func (h *Handler) Delete(ctx context.Context, req *grpc.DeleteRequest) (*grpc.DeleteResponse, error) {
+	if req == nil {
+		return nil, NilRequestInputError
+	}
+
+	id, err := h.resolveJob(ctx, req.Name)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	if _, err := h.svc.Delete(ctx, job.DeleteInput{ID: id, Force: req.Force}); err != nil {
+		return nil, TranslateError(err)
+	}
+	return &grpc.DeleteResponse{}, nil
+}
+
 func (h *Handler) StreamLogs(req *grpc.StreamLogsRequest, gstream grpc.JobService_StreamLogsServer) error {
 	if req == nil {
 		return NilRequestInputError
 	}
 
-	ctx, jobName := gstream.Context(), req.Name
+	ctx := gstream.Context()
 
-	if err := h.checkAuthorized(ctx, jobName); err != nil {
+	id, err := h.resolveJob(ctx, req.Name)
+	if err != nil {
 		return TranslateError(err)
 	}
 
 	// It's up to the 'StreamLogs' method to close the returned channels as it sends the data to it.
 	// We can only use 'ctx' to cancel streaming and release associated resources.
 	// TODO(simplification): In the future, change the returned channels to io.ReadCloser to make more readable and less error-prone API.
-	stream, err := h.svc.StreamLogs(ctx, job.StreamLogsInput{Name: jobName})
+	in := job.StreamLogsInput{
+		ID:          id,
+		Follow:      req.Follow,
+		TailLines:   req.TailLines,
+		SinceOffset: req.SinceByteOffset,
+		LimitBytes:  req.LimitBytes,
+	}
+	for _, s := range req.Streams {
+		stream, found := logStreams[s]
+		if !found {
+			return status.Errorf(codes.InvalidArgument, "unknown log stream %d", s)
+		}
+		in.Streams = append(in.Streams, stream)
+	}
+	stream, err := h.svc.StreamLogs(ctx, in)
 	if err != nil {
 		return TranslateError(err)
 	}
@@ -148,7 +373,9 @@ func (h *Handler) StreamLogs(req *grpc.StreamLogsRequest, gstream grpc.JobServic
 			}
 
 			err := gstream.Send(&grpc.StreamLogsResponse{
-				Output: out,
+				Output: out.Data,
+				Stream: mapToGRPCLogStream(out.Stream),
+				Offset: out.Offset,
 			})
 			if err != nil {
 				return TranslateError(err)
@@ -163,37 +390,253 @@ func (h *Handler) StreamLogs(req *grpc.StreamLogsRequest, gstream grpc.JobServic
 	}
 }
 
+func (h *Handler) GetStats(ctx context.Context, req *grpc.GetStatsRequest) (*grpc.GetStatsResponse, error) {
+	if req == nil {
+		return nil, NilRequestInputError
+	}
+
+	id, err := h.resolveJob(ctx, req.Name)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	out, err := h.svc.GetStats(ctx, job.GetStatsInput{ID: id})
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	return mapToGRPCStats(out), nil
+}
+
+func (h *Handler) WatchStats(req *grpc.WatchStatsRequest, gstream grpc.JobService_WatchStatsServer) error {
+	if req == nil {
+		return NilRequestInputError
+	}
+
+	ctx := gstream.Context()
+	id, err := h.resolveJob(ctx, req.Name)
+	if err != nil {
+		return TranslateError(err)
+	}
+
+	interval := defaultWatchStatsInterval
+	if req.Interval != nil && *req.Interval != 0 {
+		interval = *req.Interval
+	}
+	if interval < minWatchStatsInterval {
+		return status.Errorf(codes.InvalidArgument, "interval cannot be lower than %s", minWatchStatsInterval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		out, err := h.svc.GetStats(ctx, job.GetStatsInput{ID: id})
+		if err != nil {
+			return TranslateError(err)
+		}
+
+		if err := gstream.Send(mapToGRPCStats(out)); err != nil {
+			return TranslateError(err)
+		}
+
+		if out.Status != job.Running { // stats won't change anymore
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
 func (*Handler) Ping(_ context.Context, req *grpc.PingRequest) (*grpc.PingResponse, error) {
 	return &grpc.PingResponse{
 		Message: req.Message,
 	}, nil
 }
 
+// resolveJob returns ID of a given Job if the caller is authorized to access it. Reference is either Job ID,
+// Job name within the caller's namespace, or namespaced name, e.g. "Ricky/build".
 // TODO: it will be good to handle that directly in one place (e.g. interceptor)
 // but it a bit hard to get the req.Name without playing with type casting
-// for all known handler. It will be better to have a global "namespaces" per
-// tenant.
-func (h *Handler) checkAuthorized(ctx context.Context, jobName string) error {
+// for all known handler.
+func (h *Handler) resolveJob(ctx context.Context, ref string) (string, error) {
 	user, err := auth.FromContext(ctx)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	out, err := h.tenantGetter.GetJobTenant(repo.GetJobTenantInput{
-		Name: jobName,
-	})
-	if err != nil {
-		return errors.Wrap(err, "while resoling Job's tenant")
+	in := repo.ResolveInput{ID: ref, Tenant: user.Name, Name: ref}
+	if tenant, name, ok := job.SplitNamespacedName(ref); ok {
+		// checked upfront, so names in not owned namespaces cannot be probed
+		if err := user.CheckAuthorized(tenant); err != nil {
+			return "", err
+		}
+		in = repo.ResolveInput{Tenant: tenant, Name: name}
 	}
 
-	err = user.CheckAuthorized(out.Tenant)
+	out, err := h.resolver.Resolve(in)
 	if err != nil {
-		return err
+		return "", errors.Wrap(err, "while resolving Job")
 	}
 
-	return nil
+	if err := user.CheckAuthorized(out.Tenant); err != nil {
+		return "", err
+	}
+
+	return out.ID, nil
+}
+
+// tenantOrSelf returns a given tenant, or the user name if tenant is empty (all tenants are requested).
+func tenantOrSelf(tenant string, user *auth.User) string {
+	if tenant == "" {
+		return user.Name
+	}
+	return tenant
 }
 
 func mapToGRPCStatus(in job.Status) grpc.Status {
 	return grpc.Status(grpc.Status_value[string(in)]) // TODO: rethink
 }
+
+func mapToGRPCTerminationReason(in job.TerminationReason) grpc.TerminationReason {
+	return grpc.TerminationReason(grpc.TerminationReason_value[string(in)])
+}
+
+func mapToGRPCStats(in *job.GetStatsOutput) *grpc.GetStatsResponse {
+	stats := in.Stats
+	out := &grpc.GetStatsResponse{
+		Status: mapToGRPCStatus(in.Status),
+		Stats: &grpc.Stats{
+			Cpu: &grpc.CPUStats{
+				UsageUsec:     stats.CPU.UsageUsec,
+				UserUsec:      stats.CPU.UserUsec,
+				SystemUsec:    stats.CPU.SystemUsec,
+				NrPeriods:     stats.CPU.NrPeriods,
+				NrThrottled:   stats.CPU.NrThrottled,
+				ThrottledUsec: stats.CPU.ThrottledUsec,
+			},
+			Memory: &grpc.MemoryStats{
+				Current: stats.Memory.Current,
+				Peak:    stats.Memory.Peak,
+				Events: &grpc.MemoryEvents{
+					Low:     stats.Memory.Events.Low,
+					High:    stats.Memory.Events.High,
+					Max:     stats.Memory.Events.Max,
+					Oom:     stats.Memory.Events.OOM,
+					OomKill: stats.Memory.Events.OOMKill,
+				},
+			},
+		},
+	}
+	for _, entry := range stats.IO {
+		out.Stats.Io = append(out.Stats.Io, &grpc.IOStats{
+			Major:      entry.Major,
+			Minor:      entry.Minor,
+			ReadBytes:  entry.ReadBytes,
+			WriteBytes: entry.WriteBytes,
+			ReadIos:    entry.ReadIOs,
+			WriteIos:   entry.WriteIOs,
+		})
+	}
+	return out
+}
+
+// mapToIsolation returns empty isolation if not set, so the Agent's default is used.
+func mapToIsolation(in grpc.Isolation) job.Isolation {
+	if in == grpc.Isolation_DEFAULT_ISOLATION {
+		return ""
+	}
+	return job.Isolation(in.String())
+}
+
+func mapToRestartOptions(in *grpc.RestartOptions) job.RestartOptions {
+	if in == nil {
+		return job.RestartOptions{}
+	}
+
+	out := job.RestartOptions{
+		Policy:      job.RestartPolicy(in.Policy.String()),
+		MaxRestarts: int(in.MaxRestarts),
+	}
+	if in.Backoff != nil {
+		out.Backoff = *in.Backoff
+	}
+	return out
+}
+
+func mapToGRPCAttempts(in []job.Attempt) []*grpc.Attempt {
+	out := make([]*grpc.Attempt, 0, len(in))
+	for _, attempt := range in {
+		out = append(out, &grpc.Attempt{
+			StartedAt:  mapToGRPCTime(attempt.StartedAt),
+			FinishedAt: mapToGRPCTime(attempt.FinishedAt),
+			ExitCode:   int32(attempt.ExitCode),
+			Signal:     attempt.Signal,
+		})
+	}
+	return out
+}
+
+// mapToGRPCTime returns nil for zero time, so unknown timestamps are not sent.
+func mapToGRPCTime(in time.Time) *time.Time {
+	if in.IsZero() {
+		return nil
+	}
+	return &in
+}
+
+var logStreams = map[grpc.LogStream]file.Stream{
+	grpc.LogStream_STDOUT: file.Stdout,
+	grpc.LogStream_STDERR: file.Stderr,
+}
+
+func mapToGRPCLogStream(in file.Stream) grpc.LogStream {
+	if in == file.Stderr {
+		return grpc.LogStream_STDERR
+	}
+	return grpc.LogStream_STDOUT
+}
+
+var ioTypes = map[grpc.IOType]cgroup.IOType{
+	grpc.IOType_RBPS:  cgroup.ReadBPS,
+	grpc.IOType_WBPS:  cgroup.WriteBPS,
+	grpc.IOType_RIOPS: cgroup.ReadIOPS,
+	grpc.IOType_WIOPS: cgroup.WriteIOPS,
+}
+
+func mapToCgroupResources(in *grpc.Resources) *cgroup.Resources {
+	if in == nil {
+		return nil
+	}
+
+	out := &cgroup.Resources{}
+	if in.Cpu != nil {
+		out.CPU = &cgroup.CPU{
+			Max:  in.Cpu.Max,
+			Cpus: in.Cpu.Cpus,
+			Mems: in.Cpu.Mems,
+		}
+	}
+	if in.Memory != nil {
+		out.Memory = &cgroup.Memory{
+			Min: in.Memory.Min,
+			Max: in.Memory.Max,
+		}
+	}
+	if in.Io != nil {
+		out.IO = &cgroup.IO{}
+		for _, entry := range in.Io.Max {
+			out.IO.Max = append(out.IO.Max, cgroup.IOMaxEntry{
+				Type:  ioTypes[entry.Type],
+				Major: entry.Major,
+				Minor: entry.Minor,
+				Rate:  entry.Rate,
+			})
+		}
+	}
+	return out
+}
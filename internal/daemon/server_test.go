@@ -2,7 +2,9 @@ package daemon_test
 
 import (
 	"context"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
@@ -15,26 +17,85 @@ import (
 	"github.com/mszostok/job-runner/internal/daemon"
 	"github.com/mszostok/job-runner/internal/daemon/automock"
 	"github.com/mszostok/job-runner/pkg/api/grpc"
+	"github.com/mszostok/job-runner/pkg/cgroup"
 	"github.com/mszostok/job-runner/pkg/job"
 	"github.com/mszostok/job-runner/pkg/job/repo"
 )
 
+const jobID = "4f1c2a9be07d53a6c8e1"
+
 func TestHandler_Run_Success(t *testing.T) {
 	// given
 	serviceMock := &automock.JobService{}
-	fetcherMock := &automock.TenantGetter{}
-	handler := daemon.NewHandler(serviceMock, fetcherMock)
+	resolverMock := &automock.JobResolver{}
+	handler := daemon.NewHandler(serviceMock, resolverMock)
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+
+	req := grpc.RunRequest{
+		Name:                "test-name",
+		Command:             "sh",
+		Args:                []string{"-c", "echo $MOTTO"},
+		Env:                 []string{"MOTTO=hakuna_matata"},
+		WorkingDir:          "/srv/app",
+		User:                "builder",
+		Group:               "builder",
+		SupplementaryGroups: []string{"docker"},
+		Umask:               "0027",
+		Isolation:           grpc.Isolation_NAMESPACES_WITH_NETWORK,
+	}
+
+	ctx := auth.NewContext(context.Background(), &user)
+
+	serviceMock.EXPECT().Run(ctx, job.RunInput{
+		Tenant:              user.Name,
+		Name:                req.Name,
+		Command:             req.Command,
+		Args:                req.Args,
+		Env:                 req.Env,
+		WorkingDir:          req.WorkingDir,
+		User:                req.User,
+		Group:               req.Group,
+		SupplementaryGroups: req.SupplementaryGroups,
+		Umask:               req.Umask,
+		Isolation:           job.IsolationNamespacesWithNetwork,
+	}).Return(&job.RunOutput{ID: jobID, Name: req.Name}, nil).Once()
+
+	// when
+	out, err := handler.Run(ctx, &req)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, jobID, out.Id)
+	assert.Equal(t, req.Name, out.Name)
+
+	serviceMock.AssertExpectations(t)
+	resolverMock.AssertExpectations(t)
+}
+
+func TestHandler_Run_RestartPolicy(t *testing.T) {
+	// given
+	serviceMock := &automock.JobService{}
+	resolverMock := &automock.JobResolver{}
+	handler := daemon.NewHandler(serviceMock, resolverMock)
 
 	user := auth.User{
 		Name:  "Ricky",
 		Roles: map[string]struct{}{"user": {}},
 	}
 
+	backoff := 2 * time.Second
 	req := grpc.RunRequest{
 		Name:    "test-name",
-		Command: "sh",
-		Args:    []string{"-c", "echo $MOTTO"},
-		Env:     []string{"MOTTO=hakuna_matata"},
+		Command: "./flaky-test.sh",
+		Restart: &grpc.RestartOptions{
+			Policy:      grpc.RestartPolicy_ON_FAILURE,
+			MaxRestarts: 5,
+			Backoff:     &backoff,
+		},
 	}
 
 	ctx := auth.NewContext(context.Background(), &user)
@@ -43,8 +104,11 @@ func TestHandler_Run_Success(t *testing.T) {
 		Tenant:  user.Name,
 		Name:    req.Name,
 		Command: req.Command,
-		Args:    req.Args,
-		Env:     req.Env,
+		Restart: job.RestartOptions{
+			Policy:      job.RestartOnFailure,
+			MaxRestarts: 5,
+			Backoff:     backoff,
+		},
 	}).Return(&job.RunOutput{}, nil).Once()
 
 	// when
@@ -55,7 +119,7 @@ func TestHandler_Run_Success(t *testing.T) {
 	assert.NotNil(t, out)
 
 	serviceMock.AssertExpectations(t)
-	fetcherMock.AssertExpectations(t)
+	resolverMock.AssertExpectations(t)
 }
 
 func TestHandler_Run_Failures(t *testing.T) {
@@ -85,6 +149,18 @@ func TestHandler_Run_Failures(t *testing.T) {
 			serviceError: repo.NewIDCConflictError("test"),
 			expCode:      codes.AlreadyExists,
 		},
+		{
+			name:         "Should return invalid argument error",
+			ctx:          auth.NewContext(context.Background(), user()),
+			serviceError: job.NewInvalidInputError("invalid resources"),
+			expCode:      codes.InvalidArgument,
+		},
+		{
+			name:         "Should return permission denied error",
+			ctx:          auth.NewContext(context.Background(), user()),
+			serviceError: job.NewPermissionDeniedError("tenant is not allowed to run Jobs as UID 0"),
+			expCode:      codes.PermissionDenied,
+		},
 		{
 			name:         "Should return internal error",
 			ctx:          auth.NewContext(context.Background(), user()),
@@ -98,8 +174,8 @@ func TestHandler_Run_Failures(t *testing.T) {
 			t.Parallel()
 			// given
 			serviceMock := &automock.JobService{}
-			fetcherMock := &automock.TenantGetter{}
-			handler := daemon.NewHandler(serviceMock, fetcherMock)
+			resolverMock := &automock.JobResolver{}
+			handler := daemon.NewHandler(serviceMock, resolverMock)
 
 			req := grpc.RunRequest{
 				Name:    "test-name",
@@ -122,9 +198,443 @@ func TestHandler_Run_Failures(t *testing.T) {
 			assert.Nil(t, out)
 
 			serviceMock.AssertExpectations(t)
-			fetcherMock.AssertExpectations(t)
+			resolverMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_List_TenantScope(t *testing.T) {
+	tests := []struct {
+		name      string
+		user      *auth.User
+		reqTenant string
+
+		expTenant string
+		expCode   codes.Code
+	}{
+		{
+			name:      "Should list only owned Jobs for user",
+			user:      &auth.User{Name: "Ricky", Roles: map[string]struct{}{"user": {}}},
+			expTenant: "Ricky",
+		},
+		{
+			name:      "Should list owned Jobs for user if tenant is specified explicitly",
+			user:      &auth.User{Name: "Ricky", Roles: map[string]struct{}{"user": {}}},
+			reqTenant: "Ricky",
+			expTenant: "Ricky",
+		},
+		{
+			name:      "Should deny listing not owned Jobs for user",
+			user:      &auth.User{Name: "Ricky", Roles: map[string]struct{}{"user": {}}},
+			reqTenant: "Morty",
+			expCode:   codes.PermissionDenied,
+		},
+		{
+			name:      "Should list Jobs of all tenants for admin",
+			user:      &auth.User{Name: "Rick", Roles: map[string]struct{}{"admin": {}}},
+			expTenant: "",
+		},
+		{
+			name:      "Should list not owned Jobs for admin",
+			user:      &auth.User{Name: "Rick", Roles: map[string]struct{}{"admin": {}}},
+			reqTenant: "Morty",
+			expTenant: "Morty",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			// given
+			serviceMock := &automock.JobService{}
+			resolverMock := &automock.JobResolver{}
+			handler := daemon.NewHandler(serviceMock, resolverMock)
+
+			ctx := auth.NewContext(context.Background(), test.user)
+			req := grpc.ListRequest{
+				Tenant:   test.reqTenant,
+				Statuses: []grpc.Status{grpc.Status_RUNNING},
+			}
+
+			if test.expCode == codes.OK {
+				serviceMock.EXPECT().List(ctx, job.ListInput{
+					Tenant:   test.expTenant,
+					Statuses: []job.Status{job.Running},
+				}).Return(&job.ListOutput{
+					Jobs: []job.ListItem{{Name: "foo", CreatedBy: "Morty", Status: job.Running}},
+				}, nil).Once()
+			}
+
+			// when
+			out, err := handler.List(ctx, &req)
+
+			// then
+			if test.expCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, test.expCode, status.Convert(err).Code())
+				assert.Nil(t, out)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, []*grpc.JobSummary{{Name: "foo", CreatedBy: "Morty", Status: grpc.Status_RUNNING}}, out.Jobs)
+			}
+
+			serviceMock.AssertExpectations(t)
+			resolverMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_Get_Queued(t *testing.T) {
+	// given
+	serviceMock := &automock.JobService{}
+	resolverMock := &automock.JobResolver{}
+	handler := daemon.NewHandler(serviceMock, resolverMock)
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+	createdAt := time.Date(2022, 3, 8, 10, 0, 0, 0, time.UTC)
+
+	resolverMock.EXPECT().Resolve(repo.ResolveInput{ID: "episode-42", Tenant: user.Name, Name: "episode-42"}).
+		Return(repo.ResolveOutput{ID: jobID, Tenant: user.Name, Name: "episode-42"}, nil).Once()
+	serviceMock.EXPECT().Get(ctx, job.GetInput{ID: jobID}).
+		Return(&job.GetOutput{
+			CreatedBy:     user.Name,
+			Status:        job.Queued,
+			CreatedAt:     createdAt,
+			Attempts:      []job.Attempt{},
+			QueuePosition: 3,
+		}, nil).Once()
+
+	// when
+	out, err := handler.Get(ctx, &grpc.GetRequest{Name: "episode-42"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, grpc.Status_QUEUED, out.Status)
+	assert.Equal(t, int32(3), out.QueuePosition)
+	assert.Nil(t, out.StartedAt)
+
+	serviceMock.AssertExpectations(t)
+	resolverMock.AssertExpectations(t)
+}
+
+func TestHandler_Get_ResolveReference(t *testing.T) {
+	tests := map[string]struct {
+		user auth.User
+		ref  string
+		// runs only if namespace is authorized
+		expResolveInput *repo.ResolveInput
+		expCode         codes.Code
+	}{
+		"Should resolve name in own namespace": {
+			user:            auth.User{Name: "Ricky", Roles: map[string]struct{}{"user": {}}},
+			ref:             "episode-42",
+			expResolveInput: &repo.ResolveInput{ID: "episode-42", Tenant: "Ricky", Name: "episode-42"},
+			expCode:         codes.OK,
+		},
+		"Should resolve ID": {
+			user:            auth.User{Name: "Ricky", Roles: map[string]struct{}{"user": {}}},
+			ref:             jobID,
+			expResolveInput: &repo.ResolveInput{ID: jobID, Tenant: "Ricky", Name: jobID},
+			expCode:         codes.OK,
+		},
+		"Should resolve own namespaced name": {
+			user:            auth.User{Name: "Ricky", Roles: map[string]struct{}{"user": {}}},
+			ref:             "Ricky/episode-42",
+			expResolveInput: &repo.ResolveInput{Tenant: "Ricky", Name: "episode-42"},
+			expCode:         codes.OK,
+		},
+		"Should resolve namespaced name of other tenant for admin": {
+			user:            auth.User{Name: "Admin", Roles: map[string]struct{}{"admin": {}}},
+			ref:             "Ricky/episode-42",
+			expResolveInput: &repo.ResolveInput{Tenant: "Ricky", Name: "episode-42"},
+			expCode:         codes.OK,
+		},
+		"Should reject namespaced name of other tenant before resolving it": {
+			user:    auth.User{Name: "Morty", Roles: map[string]struct{}{"user": {}}},
+			ref:     "Ricky/episode-42",
+			expCode: codes.PermissionDenied,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			serviceMock := &automock.JobService{}
+			resolverMock := &automock.JobResolver{}
+			handler := daemon.NewHandler(serviceMock, resolverMock)
+
+			user := tc.user
+			ctx := auth.NewContext(context.Background(), &user)
+
+			if tc.expResolveInput != nil {
+				resolverMock.EXPECT().Resolve(*tc.expResolveInput).
+					Return(repo.ResolveOutput{ID: jobID, Tenant: "Ricky", Name: "episode-42"}, nil).Once()
+				serviceMock.EXPECT().Get(ctx, job.GetInput{ID: jobID}).
+					Return(&job.GetOutput{ID: jobID, Name: "episode-42", CreatedBy: "Ricky", Status: job.Running}, nil).Once()
+			}
+
+			// when
+			out, err := handler.Get(ctx, &grpc.GetRequest{Name: tc.ref})
+
+			// then
+			assert.Equal(t, tc.expCode, status.Code(err))
+			if tc.expCode == codes.OK {
+				assert.Equal(t, jobID, out.Id)
+				assert.Equal(t, "episode-42", out.Name)
+			}
+
+			serviceMock.AssertExpectations(t)
+			resolverMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_WatchStats(t *testing.T) {
+	// given
+	serviceMock := &automock.JobService{}
+	resolverMock := &automock.JobResolver{}
+	handler := daemon.NewHandler(serviceMock, resolverMock)
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+	stream := &fakeWatchStatsServer{ctx: ctx}
+
+	resolverMock.EXPECT().Resolve(repo.ResolveInput{ID: "episode-42", Tenant: user.Name, Name: "episode-42"}).
+		Return(repo.ResolveOutput{ID: jobID, Tenant: user.Name, Name: "episode-42"}, nil).Once()
+
+	running := &job.GetStatsOutput{Status: job.Running, Stats: cgroup.GroupStats{CPU: cgroup.CPUStats{UsageUsec: 100}}}
+	finished := &job.GetStatsOutput{Status: job.Succeeded, Stats: cgroup.GroupStats{CPU: cgroup.CPUStats{UsageUsec: 200}}}
+	serviceMock.EXPECT().GetStats(ctx, job.GetStatsInput{ID: jobID}).Return(running, nil).Once()
+	serviceMock.EXPECT().GetStats(ctx, job.GetStatsInput{ID: jobID}).Return(finished, nil).Once()
+
+	interval := 100 * time.Millisecond
+
+	// when
+	err := handler.WatchStats(&grpc.WatchStatsRequest{Name: "episode-42", Interval: &interval}, stream)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, stream.sent, 2)
+	assert.Equal(t, grpc.Status_RUNNING, stream.sent[0].Status)
+	assert.EqualValues(t, 100, stream.sent[0].Stats.Cpu.UsageUsec)
+	assert.Equal(t, grpc.Status_SUCCEEDED, stream.sent[1].Status)
+	assert.EqualValues(t, 200, stream.sent[1].Stats.Cpu.UsageUsec)
+
+	serviceMock.AssertExpectations(t)
+	resolverMock.AssertExpectations(t)
+}
+
+func TestHandler_WatchStats_InvalidInterval(t *testing.T) {
+	// given
+	serviceMock := &automock.JobService{}
+	resolverMock := &automock.JobResolver{}
+	handler := daemon.NewHandler(serviceMock, resolverMock)
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+
+	resolverMock.EXPECT().Resolve(repo.ResolveInput{ID: "episode-42", Tenant: user.Name, Name: "episode-42"}).
+		Return(repo.ResolveOutput{ID: jobID, Tenant: user.Name, Name: "episode-42"}, nil).Once()
+
+	interval := time.Millisecond
+
+	// when
+	err := handler.WatchStats(&grpc.WatchStatsRequest{Name: "episode-42", Interval: &interval}, &fakeWatchStatsServer{ctx: ctx})
+
+	// then
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+	serviceMock.AssertExpectations(t)
+	resolverMock.AssertExpectations(t)
+}
+
+func TestHandler_Signal(t *testing.T) {
+	// given
+	serviceMock := &automock.JobService{}
+	resolverMock := &automock.JobResolver{}
+	handler := daemon.NewHandler(serviceMock, resolverMock)
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+
+	resolverMock.EXPECT().Resolve(repo.ResolveInput{ID: "episode-42", Tenant: user.Name, Name: "episode-42"}).
+		Return(repo.ResolveOutput{ID: jobID, Tenant: user.Name, Name: "episode-42"}, nil).Once()
+	serviceMock.EXPECT().Signal(ctx, job.SignalInput{ID: jobID, Signal: syscall.SIGHUP}).
+		Return(&job.SignalOutput{}, nil).Once()
+
+	// when
+	_, err := handler.Signal(ctx, &grpc.SignalRequest{Name: "episode-42", Signal: "hup"})
+
+	// then
+	require.NoError(t, err)
+
+	serviceMock.AssertExpectations(t)
+	resolverMock.AssertExpectations(t)
+}
+
+func TestHandler_Signal_Failures(t *testing.T) {
+	tests := map[string]struct {
+		req *grpc.SignalRequest
+		// runs only if signal is valid
+		svcErr  error
+		expCode codes.Code
+	}{
+		"Should reject unknown signal": {
+			req:     &grpc.SignalRequest{Name: "episode-42", Signal: "SIGFOO"},
+			expCode: codes.InvalidArgument,
+		},
+		"Should reject signal for not running Job": {
+			req:     &grpc.SignalRequest{Name: "episode-42", Signal: "SIGINT"},
+			svcErr:  job.NewNotRunningError("episode-42", job.Succeeded),
+			expCode: codes.FailedPrecondition,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			serviceMock := &automock.JobService{}
+			resolverMock := &automock.JobResolver{}
+			handler := daemon.NewHandler(serviceMock, resolverMock)
+
+			user := auth.User{
+				Name:  "Ricky",
+				Roles: map[string]struct{}{"user": {}},
+			}
+			ctx := auth.NewContext(context.Background(), &user)
+
+			resolverMock.EXPECT().Resolve(repo.ResolveInput{ID: "episode-42", Tenant: user.Name, Name: "episode-42"}).
+				Return(repo.ResolveOutput{ID: jobID, Tenant: user.Name, Name: "episode-42"}, nil).Once()
+			if tc.svcErr != nil {
+				serviceMock.EXPECT().Signal(ctx, mock.Anything).Return(nil, tc.svcErr).Once()
+			}
+
+			// when
+			_, err := handler.Signal(ctx, tc.req)
+
+			// then
+			require.Error(t, err)
+			assert.Equal(t, tc.expCode, status.Convert(err).Code())
+
+			serviceMock.AssertExpectations(t)
+			resolverMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_Delete(t *testing.T) {
+	tests := map[string]struct {
+		jobTenant string
+		// runs only if tenant is authorized
+		svcErr  error
+		expCode codes.Code
+	}{
+		"Should delete own finished Job": {
+			jobTenant: "Ricky",
+			expCode:   codes.OK,
+		},
+		"Should reject Job of other tenant": {
+			jobTenant: "Morty",
+			expCode:   codes.PermissionDenied,
+		},
+		"Should reject not finished Job": {
+			jobTenant: "Ricky",
+			svcErr:    job.NewNotFinishedError("episode-42", job.Running),
+			expCode:   codes.FailedPrecondition,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			serviceMock := &automock.JobService{}
+			resolverMock := &automock.JobResolver{}
+			handler := daemon.NewHandler(serviceMock, resolverMock)
+
+			user := auth.User{
+				Name:  "Ricky",
+				Roles: map[string]struct{}{"user": {}},
+			}
+			ctx := auth.NewContext(context.Background(), &user)
+
+			resolverMock.EXPECT().Resolve(repo.ResolveInput{ID: "episode-42", Tenant: user.Name, Name: "episode-42"}).
+				Return(repo.ResolveOutput{ID: jobID, Tenant: tc.jobTenant, Name: "episode-42"}, nil).Once()
+			if tc.jobTenant == user.Name {
+				var out *job.DeleteOutput
+				if tc.svcErr == nil {
+					out = &job.DeleteOutput{}
+				}
+				serviceMock.EXPECT().Delete(ctx, job.DeleteInput{ID: jobID}).Return(out, tc.svcErr).Once()
+			}
+
+			// when
+			_, err := handler.Delete(ctx, &grpc.DeleteRequest{Name: "episode-42"})
+
+			// then
+			assert.Equal(t, tc.expCode, status.Code(err))
+
+			serviceMock.AssertExpectations(t)
+			resolverMock.AssertExpectations(t)
 		})
 	}
 }
 
+func TestHandler_Stop_Signal(t *testing.T) {
+	// given
+	serviceMock := &automock.JobService{}
+	resolverMock := &automock.JobResolver{}
+	handler := daemon.NewHandler(serviceMock, resolverMock)
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+
+	resolverMock.EXPECT().Resolve(repo.ResolveInput{ID: "episode-42", Tenant: user.Name, Name: "episode-42"}).
+		Return(repo.ResolveOutput{ID: jobID, Tenant: user.Name, Name: "episode-42"}, nil).Once()
+	serviceMock.EXPECT().Stop(ctx, job.StopInput{ID: jobID, Signal: syscall.SIGINT, StoppedBy: user.Name}).
+		Return(&job.StopOutput{Status: job.Terminated, TerminationReason: job.StopRequested, Signal: "SIGINT"}, nil).Once()
+
+	// when
+	out, err := handler.Stop(ctx, &grpc.StopRequest{Name: "episode-42", StopSignal: "SIGINT"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, grpc.Status_TERMINATED, out.Status)
+	assert.Equal(t, grpc.TerminationReason_STOP_REQUESTED, out.TerminationReason)
+	assert.Equal(t, "SIGINT", out.Signal)
+
+	serviceMock.AssertExpectations(t)
+	resolverMock.AssertExpectations(t)
+}
+
+// fakeWatchStatsServer records all sent responses.
+type fakeWatchStatsServer struct {
+	grpc.JobService_WatchStatsServer
+
+	ctx  context.Context
+	sent []*grpc.GetStatsResponse
+}
+
+func (f *fakeWatchStatsServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeWatchStatsServer) Send(resp *grpc.GetStatsResponse) error {
+	f.sent = append(f.sent, resp)
+	return nil
+}
+
 // TODO(simplification): test rest handlers
@@ -0,0 +1,106 @@
+package daemon
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mszostok/job-runner/internal/auth"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+	"github.com/mszostok/job-runner/pkg/job"
+	"github.com/mszostok/job-runner/pkg/workflow"
+)
+
+// WorkflowService provides functionality to execute Workflows.
+//go:generate mockery --name=WorkflowService --output=automock --outpkg=automock --case=underscore --with-expecter
+type WorkflowService interface {
+	Submit(context.Context, workflow.SubmitInput) (*workflow.SubmitOutput, error)
+	Get(context.Context, workflow.GetInput) (*workflow.GetOutput, error)
+}
+
+func (h *Handler) SubmitWorkflow(ctx context.Context, req *grpc.SubmitWorkflowRequest) (*grpc.SubmitWorkflowResponse, error) {
+	if req == nil {
+		return nil, NilRequestInputError
+	}
+	if h.workflows == nil {
+		return h.UnimplementedJobServiceServer.SubmitWorkflow(ctx, req)
+	}
+
+	user, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	in := workflow.SubmitInput{
+		Tenant: user.Name,
+		Name:   req.Name,
+	}
+	for _, step := range req.Steps {
+		if step.JobTemplate == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Job template of step %q cannot be empty", step.Name)
+		}
+		in.Steps = append(in.Steps, workflow.Step{
+			Name:      step.Name,
+			DependsOn: step.DependsOn,
+			Template:  mapToJobTemplate(step.JobTemplate),
+		})
+	}
+
+	if _, err := h.workflows.Submit(ctx, in); err != nil {
+		return nil, TranslateError(err)
+	}
+	return &grpc.SubmitWorkflowResponse{}, nil
+}
+
+func (h *Handler) GetWorkflow(ctx context.Context, req *grpc.GetWorkflowRequest) (*grpc.GetWorkflowResponse, error) {
+	if req == nil {
+		return nil, NilRequestInputError
+	}
+	if h.workflows == nil {
+		return h.UnimplementedJobServiceServer.GetWorkflow(ctx, req)
+	}
+
+	user, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+
+	// Workflow is resolved in the caller's namespace, unless namespaced name, e.g. "Ricky/release", is given
+	tenant, name, ok := job.SplitNamespacedName(req.Name)
+	if !ok {
+		tenant, name = user.Name, req.Name
+	}
+	// checked upfront, so names in not owned namespaces cannot be probed
+	if err := user.CheckAuthorized(tenant); err != nil {
+		return nil, TranslateError(err)
+	}
+
+	out, err := h.workflows.Get(ctx, workflow.GetInput{Tenant: tenant, Name: name})
+	if err != nil {
+		return nil, TranslateError(err)
+	}
+	wf := out.Workflow
+
+	resp := &grpc.GetWorkflowResponse{
+		Name:       wf.Name,
+		CreatedBy:  wf.Tenant,
+		Status:     grpc.WorkflowStatus(grpc.WorkflowStatus_value["WORKFLOW_"+string(wf.Status)]),
+		CreatedAt:  mapToGRPCTime(wf.CreatedAt),
+		FinishedAt: mapToGRPCTime(wf.FinishedAt),
+	}
+	for _, step := range wf.Steps {
+		resp.Steps = append(resp.Steps, &grpc.StepState{
+			Name:       step.Name,
+			DependsOn:  step.DependsOn,
+			JobName:    step.JobName,
+			JobId:      step.JobID,
+			Status:     grpc.StepStatus(grpc.StepStatus_value["STEP_"+string(step.Status)]),
+			ExitCode:   int32(step.ExitCode),
+			Error:      step.Error,
+			StartedAt:  mapToGRPCTime(step.StartedAt),
+			FinishedAt: mapToGRPCTime(step.FinishedAt),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,123 @@
+package daemon_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mszostok/job-runner/internal/auth"
+	"github.com/mszostok/job-runner/internal/daemon"
+	"github.com/mszostok/job-runner/internal/daemon/automock"
+	"github.com/mszostok/job-runner/pkg/api/grpc"
+	"github.com/mszostok/job-runner/pkg/job"
+	"github.com/mszostok/job-runner/pkg/workflow"
+)
+
+func TestHandler_SubmitWorkflow(t *testing.T) {
+	// given
+	workflowMock := &automock.WorkflowService{}
+	handler := daemon.NewHandler(&automock.JobService{}, &automock.JobResolver{}, daemon.WithWorkflowService(workflowMock))
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+
+	workflowMock.EXPECT().Submit(ctx, workflow.SubmitInput{
+		Tenant: user.Name,
+		Name:   "release",
+		Steps: []workflow.Step{
+			{Name: "build", Template: job.Template{Command: "make", Args: []string{"build"}}},
+			{Name: "test", DependsOn: []string{"build"}, Template: job.Template{Command: "make", Args: []string{"test"}}},
+		},
+	}).Return(&workflow.SubmitOutput{}, nil).Once()
+
+	// when
+	_, err := handler.SubmitWorkflow(ctx, &grpc.SubmitWorkflowRequest{
+		Name: "release",
+		Steps: []*grpc.WorkflowStep{
+			{Name: "build", JobTemplate: &grpc.JobTemplate{Command: "make", Args: []string{"build"}}},
+			{Name: "test", DependsOn: []string{"build"}, JobTemplate: &grpc.JobTemplate{Command: "make", Args: []string{"test"}}},
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	workflowMock.AssertExpectations(t)
+}
+
+func TestHandler_GetWorkflow(t *testing.T) {
+	// given
+	workflowMock := &automock.WorkflowService{}
+	handler := daemon.NewHandler(&automock.JobService{}, &automock.JobResolver{}, daemon.WithWorkflowService(workflowMock))
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+
+	createdAt := time.Date(2022, 3, 9, 10, 0, 0, 0, time.UTC)
+	startedAt := createdAt.Add(time.Second)
+	finishedAt := createdAt.Add(time.Minute)
+
+	workflowMock.EXPECT().Get(ctx, workflow.GetInput{Tenant: "Ricky", Name: "release"}).Return(&workflow.GetOutput{
+		Workflow: workflow.Workflow{
+			Name:       "release",
+			Tenant:     user.Name,
+			Status:     workflow.Failed,
+			CreatedAt:  createdAt,
+			FinishedAt: finishedAt,
+			Steps: []workflow.StepState{
+				{Name: "build", JobName: "release-build-x7k2p", Status: workflow.StepFailed, ExitCode: 2, StartedAt: startedAt, FinishedAt: finishedAt},
+				{Name: "test", DependsOn: []string{"build"}, Status: workflow.StepSkipped, FinishedAt: finishedAt},
+			},
+		},
+	}, nil).Once()
+
+	// when
+	out, err := handler.GetWorkflow(ctx, &grpc.GetWorkflowRequest{Name: "release"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, &grpc.GetWorkflowResponse{
+		Name:       "release",
+		CreatedBy:  user.Name,
+		Status:     grpc.WorkflowStatus_WORKFLOW_FAILED,
+		CreatedAt:  &createdAt,
+		FinishedAt: &finishedAt,
+		Steps: []*grpc.StepState{
+			{Name: "build", JobName: "release-build-x7k2p", Status: grpc.StepStatus_STEP_FAILED, ExitCode: 2, StartedAt: &startedAt, FinishedAt: &finishedAt},
+			{Name: "test", DependsOn: []string{"build"}, Status: grpc.StepStatus_STEP_SKIPPED, FinishedAt: &finishedAt},
+		},
+	}, out)
+
+	workflowMock.AssertExpectations(t)
+}
+
+func TestHandler_GetWorkflow_Unauthorized(t *testing.T) {
+	// given
+	workflowMock := &automock.WorkflowService{}
+	handler := daemon.NewHandler(&automock.JobService{}, &automock.JobResolver{}, daemon.WithWorkflowService(workflowMock))
+
+	user := auth.User{
+		Name:  "Ricky",
+		Roles: map[string]struct{}{"user": {}},
+	}
+	ctx := auth.NewContext(context.Background(), &user)
+
+	// when
+	_, err := handler.GetWorkflow(ctx, &grpc.GetWorkflowRequest{Name: "Morty/release"})
+
+	// then
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+	workflowMock.AssertExpectations(t)
+}
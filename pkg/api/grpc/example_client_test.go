@@ -49,11 +49,11 @@ func ExampleJobServiceClient() {
 
 	fmt.Printf("'Get' of just started Job: Job create by %q status %q, exit code %d\n\n", getOut.CreatedBy, getOut.Status, getOut.ExitCode)
 
-	stream, err := client.StreamLogs(ctx, &pb.StreamLogsRequest{Name: jobName})
+	stream, err := client.StreamLogs(ctx, &pb.StreamLogsRequest{Name: jobName, Follow: true})
 	fatalOnErr(err)
 
 	fmt.Println("Stream logs:")
-	err = pb.ForwardStreamLogs(os.Stdout, stream)
+	err = pb.ForwardStreamLogs(os.Stdout, os.Stderr, stream)
 	fatalOnErr(err)
 
 	getOut, err = client.Get(ctx, &pb.GetRequest{Name: jobName})
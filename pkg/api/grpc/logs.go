@@ -5,7 +5,8 @@ import (
 	"io"
 )
 
-func ForwardStreamLogs(w io.Writer, stream JobService_StreamLogsClient) error {
+// ForwardStreamLogs writes streamed logs to the writer matching the stream that produced a given response.
+func ForwardStreamLogs(stdout, stderr io.Writer, stream JobService_StreamLogsClient) error {
 	for {
 		resp, err := stream.Recv() // it's blocking operation, but it will be released, when stream will be closed/canceled
 		if err != nil {
@@ -15,6 +16,10 @@ func ForwardStreamLogs(w io.Writer, stream JobService_StreamLogsClient) error {
 			return err
 		}
 
+		w := stdout
+		if resp.Stream == LogStream_STDERR {
+			w = stderr
+		}
 		fmt.Fprintf(w, "%s", resp.Output) // assumption that it UTF-8
 	}
 }
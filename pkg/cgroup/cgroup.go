@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/spf13/afero"
 )
@@ -16,6 +19,8 @@ var (
 
 	// fs is used to mock filesystem in unit tests
 	fs = afero.NewOsFs()
+	// killProc is used to mock sending signals in unit tests
+	killProc = syscall.Kill
 )
 
 const (
@@ -27,6 +32,14 @@ const (
 	procsFileName = "cgroup.procs"
 	// controllers represents a file name which specifies enabled/disabled controllers for a given child group.
 	controllersFileName = "cgroup.subtree_control"
+	// killFileName represents a file name which kills all processes in the cgroup when "1" is written to it.
+	// It's available since Linux 5.14.
+	killFileName = "cgroup.kill"
+
+	// removeTimeout represents the maximum time given to killed processes to leave the cgroup.
+	removeTimeout = 5 * time.Second
+	// removeRetryInterval represents the interval between cgroup removal attempts.
+	removeRetryInterval = 10 * time.Millisecond
 )
 
 // CheckCgroupV2Enabled returns nil if cgroup v2 is enabled.
@@ -131,6 +144,102 @@ func BootstrapChild(groupPath string, resources Resources) error {
 	return nil
 }
 
+// Remove kills all processes that are still in a given cgroup and removes it.
+// It's a no-op if the cgroup doesn't exist.
+func Remove(groupPath string) error {
+	if err := ValidateGroupPath(groupPath); err != nil {
+		return err
+	}
+	if _, err := fs.Stat(groupPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	if err := Kill(groupPath); err != nil {
+		return fmt.Errorf("while killing cgroup processes: %w", err)
+	}
+
+	// Killed processes leave the cgroup asynchronously, until then the removal fails with EBUSY.
+	deadline := time.Now().Add(removeTimeout)
+	for {
+		err := fs.Remove(groupPath)
+		switch {
+		case err == nil, errors.Is(err, os.ErrNotExist):
+			return nil
+		case !errors.Is(err, syscall.EBUSY) || time.Now().After(deadline):
+			return fmt.Errorf("while removing cgroup: %w", err)
+		}
+		time.Sleep(removeRetryInterval)
+	}
+}
+
+// Kill sends SIGKILL to all processes in a given cgroup. If "cgroup.kill" is not supported,
+// processes listed in "cgroup.procs" are killed one by one.
+func Kill(groupPath string) error {
+	if err := ValidateGroupPath(groupPath); err != nil {
+		return err
+	}
+
+	err := writeFile(filepath.Join(groupPath, killFileName), "1", os.O_WRONLY)
+	if err == nil || !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return Signal(groupPath, syscall.SIGKILL)
+}
+
+// Signal sends a given signal to all processes in a given cgroup.
+// Processes which exited in the meantime are skipped.
+func Signal(groupPath string, sig syscall.Signal) error {
+	if err := ValidateGroupPath(groupPath); err != nil {
+		return err
+	}
+
+	pids, err := readProcs(groupPath)
+	if err != nil {
+		return err
+	}
+	for _, pid := range pids {
+		// ESRCH means that the process already exited.
+		if err := killProc(pid, sig); err != nil && !errors.Is(err, syscall.ESRCH) {
+			return fmt.Errorf("while sending %s to process %d: %w", sig, pid, err)
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns true if there are no processes in a given cgroup, or the cgroup doesn't exist.
+func IsEmpty(groupPath string) (bool, error) {
+	if err := ValidateGroupPath(groupPath); err != nil {
+		return false, err
+	}
+
+	pids, err := readProcs(groupPath)
+	if err != nil {
+		return false, err
+	}
+	return len(pids) == 0, nil
+}
+
+// readProcs returns PIDs of processes in a given cgroup. Returns nil if the cgroup doesn't exist.
+func readProcs(groupPath string) ([]int, error) {
+	data, err := readOptionalFile(filepath.Join(groupPath, procsFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	var pids []int
+	for _, line := range strings.Fields(string(data)) {
+		pid, err := strconv.Atoi(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s entry %q: %w", procsFileName, line, err)
+		}
+		pids = append(pids, pid)
+	}
+	return pids, nil
+}
+
 func writeFile(path string, data string, flag int) error {
 	f, err := fs.OpenFile(path, flag, 0644)
 	if err != nil {
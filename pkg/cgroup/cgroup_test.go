@@ -2,6 +2,7 @@ package cgroup_test
 
 import (
 	"os"
+	"syscall"
 	"testing"
 
 	"github.com/cockroachdb/errors"
@@ -71,6 +72,64 @@ func TestValidateGroupPath(t *testing.T) {
 	})
 }
 
+func TestCPUMaxCPUs(t *testing.T) {
+	tests := map[string]struct {
+		max        string
+		expCPUs    float64
+		expLimited bool
+	}{
+		"Should return fraction of CPU": {
+			max:        "100000 1000000",
+			expCPUs:    0.1,
+			expLimited: true,
+		},
+		"Should use default period": {
+			max:        cgroup.NewCPUMax(2.5),
+			expCPUs:    2.5,
+			expLimited: true,
+		},
+		"Should indicate unlimited bandwidth": {
+			max:        "max 100000",
+			expLimited: false,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			cpus, limited, err := cgroup.CPU{Max: tc.max}.MaxCPUs()
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expLimited, limited)
+			assert.InDelta(t, tc.expCPUs, cpus, 0.0001)
+		})
+	}
+
+	t.Run("Should return error for invalid format", func(t *testing.T) {
+		_, _, err := cgroup.CPU{Max: "1 2 3"}.MaxCPUs()
+		assert.Error(t, err)
+	})
+}
+
+func TestParseIOMaxEntry(t *testing.T) {
+	t.Run("Should parse valid entry", func(t *testing.T) {
+		// when
+		entry, err := cgroup.ParseIOMaxEntry("8:16 wbps=1048576")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, cgroup.IOMaxEntry{Type: cgroup.WriteBPS, Major: 8, Minor: 16, Rate: 1048576}, entry)
+		assert.Equal(t, "8:16 wbps=1048576", entry.String())
+	})
+
+	t.Run("Should return error for invalid entries", func(t *testing.T) {
+		for _, in := range []string{"", "8:0", "8 wbps=1", "8:0 wbps", "8:0 foo=1", "8:0 wbps=-1"} {
+			_, err := cgroup.ParseIOMaxEntry(in)
+			assert.Error(t, err, in)
+		}
+	})
+}
+
 type erroneousFS struct {
 	afero.Fs
 	StatError error
@@ -82,3 +141,263 @@ func (w *erroneousFS) Stat(name string) (os.FileInfo, error) {
 	}
 	return w.Fs.Stat(name)
 }
+
+func TestStats(t *testing.T) {
+	t.Run("Should read stats of all controllers", func(t *testing.T) {
+		// given
+		tFS := afero.NewMemMapFs()
+		revert := cgroup.SetFS(tFS)
+		defer revert()
+
+		group := "/sys/fs/cgroup/LPR/episode-42"
+		files := map[string]string{
+			"cpu.stat":       "usage_usec 2000\nuser_usec 1500\nsystem_usec 500\nnr_periods 10\nnr_throttled 2\nthrottled_usec 300\n",
+			"memory.current": "4096\n",
+			"memory.peak":    "8192\n",
+			"memory.events":  "low 0\nhigh 1\nmax 2\noom 3\noom_kill 1\n",
+			"io.stat":        "8:16 rbytes=1459200 wbytes=314773504 rios=192 wios=353 dbytes=0 dios=0\n",
+		}
+		for name, content := range files {
+			require.NoError(t, afero.WriteFile(tFS, group+"/"+name, []byte(content), 0644))
+		}
+
+		// when
+		stats, err := cgroup.Stats(group)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, cgroup.GroupStats{
+			CPU: cgroup.CPUStats{
+				UsageUsec:     2000,
+				UserUsec:      1500,
+				SystemUsec:    500,
+				NrPeriods:     10,
+				NrThrottled:   2,
+				ThrottledUsec: 300,
+			},
+			Memory: cgroup.MemoryStats{
+				Current: 4096,
+				Peak:    8192,
+				Events:  cgroup.MemoryEvents{High: 1, Max: 2, OOM: 3, OOMKill: 1},
+			},
+			IO: []cgroup.IOStat{
+				{Major: 8, Minor: 16, ReadBytes: 1459200, WriteBytes: 314773504, ReadIOs: 192, WriteIOs: 353},
+			},
+		}, stats)
+	})
+
+	t.Run("Should skip stats of not enabled controllers", func(t *testing.T) {
+		// given
+		tFS := afero.NewMemMapFs()
+		revert := cgroup.SetFS(tFS)
+		defer revert()
+
+		group := "/sys/fs/cgroup/LPR/episode-42"
+		require.NoError(t, afero.WriteFile(tFS, group+"/cpu.stat", []byte("usage_usec 2000\n"), 0644))
+
+		// when
+		stats, err := cgroup.Stats(group)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, cgroup.GroupStats{CPU: cgroup.CPUStats{UsageUsec: 2000}}, stats)
+	})
+
+	t.Run("Should return error if group doesn't exist", func(t *testing.T) {
+		// given
+		revert := cgroup.SetFS(afero.NewMemMapFs())
+		defer revert()
+
+		// when
+		_, err := cgroup.Stats("/sys/fs/cgroup/LPR/episode-42")
+
+		// then
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+// busyFS returns EBUSY for a given number of Remove calls, as cgroupfs does until all processes left the group.
+type busyFS struct {
+	afero.Fs
+	BusyRemovals int
+}
+
+func (w *busyFS) Remove(name string) error {
+	if w.BusyRemovals > 0 {
+		w.BusyRemovals--
+		return &os.PathError{Op: "remove", Path: name, Err: syscall.EBUSY}
+	}
+	return w.Fs.Remove(name)
+}
+
+func TestRemove(t *testing.T) {
+	const group = "/sys/fs/cgroup/LPR/episode-42"
+
+	t.Run("Should kill processes via cgroup.kill and remove group", func(t *testing.T) {
+		// given
+		tFS := &busyFS{Fs: afero.NewMemMapFs(), BusyRemovals: 2}
+		revert := cgroup.SetFS(tFS)
+		defer revert()
+
+		require.NoError(t, afero.WriteFile(tFS, group+"/cgroup.kill", nil, 0644))
+		require.NoError(t, afero.WriteFile(tFS, group+"/cgroup.procs", []byte("12\n"), 0644))
+
+		revertKill := cgroup.SetKillProc(func(pid int, sig syscall.Signal) error {
+			t.Fatalf("unexpected kill of process %d", pid)
+			return nil
+		})
+		defer revertKill()
+
+		// when
+		err := cgroup.Remove(group)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 0, tFS.BusyRemovals)
+
+		_, err = tFS.Stat(group)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("Should kill processes from cgroup.procs if cgroup.kill is not supported", func(t *testing.T) {
+		// given
+		tFS := afero.NewMemMapFs()
+		revert := cgroup.SetFS(tFS)
+		defer revert()
+
+		require.NoError(t, afero.WriteFile(tFS, group+"/cgroup.procs", []byte("12\n34\n"), 0644))
+
+		var killed []int
+		revertKill := cgroup.SetKillProc(func(pid int, sig syscall.Signal) error {
+			assert.Equal(t, syscall.SIGKILL, sig)
+			killed = append(killed, pid)
+			if pid == 34 {
+				return syscall.ESRCH // already exited
+			}
+			return nil
+		})
+		defer revertKill()
+
+		// when
+		err := cgroup.Remove(group)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []int{12, 34}, killed)
+
+		_, err = tFS.Stat(group)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("Should do nothing if group doesn't exist", func(t *testing.T) {
+		// given
+		revert := cgroup.SetFS(afero.NewMemMapFs())
+		defer revert()
+
+		// when
+		err := cgroup.Remove(group)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should return error if process cannot be killed", func(t *testing.T) {
+		// given
+		tFS := afero.NewMemMapFs()
+		revert := cgroup.SetFS(tFS)
+		defer revert()
+
+		require.NoError(t, afero.WriteFile(tFS, group+"/cgroup.procs", []byte("12\n"), 0644))
+
+		revertKill := cgroup.SetKillProc(func(int, syscall.Signal) error {
+			return syscall.EPERM
+		})
+		defer revertKill()
+
+		// when
+		err := cgroup.Remove(group)
+
+		// then
+		assert.ErrorIs(t, err, syscall.EPERM)
+
+		_, err = tFS.Stat(group)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should return error for invalid path", func(t *testing.T) {
+		// when
+		err := cgroup.Remove("/tmp/episode-42")
+
+		// then
+		assert.Error(t, err)
+	})
+}
+
+func TestSignal(t *testing.T) {
+	// given
+	const group = "/sys/fs/cgroup/LPR/episode-42"
+
+	tFS := afero.NewMemMapFs()
+	revert := cgroup.SetFS(tFS)
+	defer revert()
+
+	require.NoError(t, afero.WriteFile(tFS, group+"/cgroup.procs", []byte("12\n34\n"), 0644))
+
+	signaled := map[int]syscall.Signal{}
+	revertKill := cgroup.SetKillProc(func(pid int, sig syscall.Signal) error {
+		signaled[pid] = sig
+		return nil
+	})
+	defer revertKill()
+
+	// when
+	err := cgroup.Signal(group, syscall.SIGTERM)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, map[int]syscall.Signal{12: syscall.SIGTERM, 34: syscall.SIGTERM}, signaled)
+}
+
+func TestIsEmpty(t *testing.T) {
+	const group = "/sys/fs/cgroup/LPR/episode-42"
+
+	tests := map[string]struct {
+		procs    *string
+		expEmpty bool
+	}{
+		"Should return false if group has processes": {
+			procs:    strPtr("12\n"),
+			expEmpty: false,
+		},
+		"Should return true if group has no processes": {
+			procs:    strPtr(""),
+			expEmpty: true,
+		},
+		"Should return true if group doesn't exist": {
+			expEmpty: true,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			tFS := afero.NewMemMapFs()
+			revert := cgroup.SetFS(tFS)
+			defer revert()
+
+			if tc.procs != nil {
+				require.NoError(t, afero.WriteFile(tFS, group+"/cgroup.procs", []byte(*tc.procs), 0644))
+			}
+
+			// when
+			empty, err := cgroup.IsEmpty(group)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expEmpty, empty)
+		})
+	}
+}
+
+func strPtr(in string) *string {
+	return &in
+}
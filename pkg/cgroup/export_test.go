@@ -1,6 +1,10 @@
 package cgroup
 
-import "github.com/spf13/afero"
+import (
+	"syscall"
+
+	"github.com/spf13/afero"
+)
 
 // SetFS sets used filesystem to a given one. Returns a revert function.
 // This function is accessible only in this package and only in test files.
@@ -14,3 +18,13 @@ func SetFS(newFs afero.Fs) func() {
 	}
 	return revert
 }
+
+// SetKillProc sets function used to send signals to a given one. Returns a revert function.
+func SetKillProc(kill func(pid int, sig syscall.Signal) error) func() {
+	oldKill := killProc
+	killProc = kill
+
+	return func() {
+		killProc = oldKill
+	}
+}
@@ -2,6 +2,8 @@ package cgroup
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/mitchellh/mapstructure"
 )
@@ -19,6 +21,12 @@ const (
 	WriteIOPS IOType = "wiops"
 )
 
+// defaultCPUPeriod represents the default cpu.max period in microseconds.
+const defaultCPUPeriod = 100000
+
+// unlimited represents the cgroup value which removes a given limit.
+const unlimited = "max"
+
 type (
 	// Resources represents supported resource configuration.
 	Resources struct {
@@ -58,6 +66,71 @@ type (
 	}
 )
 
+// NewCPUMax returns cpu.max value which allows to use a given number of CPUs, e.g. 1.5 means one and a half CPU.
+func NewCPUMax(cpus float64) string {
+	return fmt.Sprintf("%d %d", int64(cpus*defaultCPUPeriod), defaultCPUPeriod)
+}
+
+// MaxCPUs returns the allowed CPU bandwidth expressed as a number of CPUs, e.g. "50000 100000" returns 0.5.
+// The second returned value is false if the bandwidth is not limited.
+func (c CPU) MaxCPUs() (float64, bool, error) {
+	fields := strings.Fields(c.Max)
+	if len(fields) == 0 || len(fields) > 2 {
+		return 0, false, fmt.Errorf("invalid cpu.max format %q, expected \"$MAX $PERIOD\"", c.Max)
+	}
+
+	period := uint64(defaultCPUPeriod)
+	if len(fields) == 2 {
+		p, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil || p == 0 {
+			return 0, false, fmt.Errorf("invalid cpu.max period %q", fields[1])
+		}
+		period = p
+	}
+
+	if fields[0] == unlimited {
+		return 0, false, nil
+	}
+	quota, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid cpu.max quota %q", fields[0])
+	}
+
+	return float64(quota) / float64(period), true, nil
+}
+
+// ParseIOMaxEntry parses IO limit in the "$MAJ:$MIN $TYPE=$RATE" format, e.g. "8:0 wbps=1048576".
+func ParseIOMaxEntry(in string) (IOMaxEntry, error) {
+	var out IOMaxEntry
+	fields := strings.Fields(in)
+	if len(fields) != 2 {
+		return IOMaxEntry{}, fmt.Errorf("invalid IO limit format %q, expected \"$MAJ:$MIN $TYPE=$RATE\"", in)
+	}
+
+	if _, err := fmt.Sscanf(fields[0], "%d:%d", &out.Major, &out.Minor); err != nil {
+		return IOMaxEntry{}, fmt.Errorf("invalid device number %q", fields[0])
+	}
+
+	limit := strings.SplitN(fields[1], "=", 2)
+	if len(limit) != 2 {
+		return IOMaxEntry{}, fmt.Errorf("invalid limit %q, expected \"$TYPE=$RATE\"", fields[1])
+	}
+	out.Type = IOType(limit[0])
+	switch out.Type {
+	case ReadBPS, WriteBPS, ReadIOPS, WriteIOPS:
+	default:
+		return IOMaxEntry{}, fmt.Errorf("unknown IO type %q", limit[0])
+	}
+
+	r, err := strconv.ParseUint(limit[1], 10, 64)
+	if err != nil {
+		return IOMaxEntry{}, fmt.Errorf("invalid rate %q", limit[1])
+	}
+	out.Rate = r
+
+	return out, nil
+}
+
 // String returns a data in io.max supported format.
 func (e IOMaxEntry) String() string {
 	return fmt.Sprintf(`%d:%d %s=%d`, e.Major, e.Minor, e.Type, e.Rate)
@@ -0,0 +1,248 @@
+package cgroup
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	cpuStatFileName       = "cpu.stat"
+	memoryCurrentFileName = "memory.current"
+	memoryPeakFileName    = "memory.peak"
+	memoryEventsFileName  = "memory.events"
+	ioStatFileName        = "io.stat"
+)
+
+type (
+	// GroupStats represents resources usage of a given cgroup.
+	GroupStats struct {
+		CPU    CPUStats
+		Memory MemoryStats
+		IO     []IOStat
+	}
+
+	// CPUStats represents data from the "cpu.stat" file.
+	CPUStats struct {
+		// UsageUsec specifies total CPU time consumed by the group in microseconds.
+		UsageUsec uint64
+		// UserUsec specifies CPU time consumed in user mode in microseconds.
+		UserUsec uint64
+		// SystemUsec specifies CPU time consumed in kernel mode in microseconds.
+		SystemUsec uint64
+		// NrPeriods specifies the number of enforcement periods that have elapsed.
+		NrPeriods uint64
+		// NrThrottled specifies the number of periods in which the group was throttled.
+		NrThrottled uint64
+		// ThrottledUsec specifies the total time in microseconds for which the group was throttled.
+		ThrottledUsec uint64
+	}
+
+	// MemoryStats represents data from the "memory.current", "memory.peak" and "memory.events" files.
+	MemoryStats struct {
+		// Current specifies the total amount of memory currently being used by the group in bytes.
+		Current uint64
+		// Peak specifies the max memory usage recorded for the group in bytes.
+		// It's zero if kernel doesn't support it (available since Linux 5.19).
+		Peak uint64
+		// Events holds the number of memory events.
+		Events MemoryEvents
+	}
+
+	// MemoryEvents represents data from the "memory.events" file.
+	MemoryEvents struct {
+		// Low specifies the number of times the group was reclaimed despite its low boundary.
+		Low uint64
+		// High specifies the number of times processes of the group were throttled because of the high boundary.
+		High uint64
+		// Max specifies the number of times the group's memory usage was about to go over the max boundary.
+		Max uint64
+		// OOM specifies the number of times the group's memory usage reached the limit and allocation was about to fail.
+		OOM uint64
+		// OOMKill specifies the number of processes belonging to the group killed by OOM killer.
+		OOMKill uint64
+	}
+
+	// IOStat represents a single entry from the "io.stat" file.
+	IOStat struct {
+		// Major specifies the device major number.
+		Major int64
+		// Minor specifies the device minor number.
+		Minor int64
+		// ReadBytes specifies the number of bytes read.
+		ReadBytes uint64
+		// WriteBytes specifies the number of bytes written.
+		WriteBytes uint64
+		// ReadIOs specifies the number of read IOs.
+		ReadIOs uint64
+		// WriteIOs specifies the number of write IOs.
+		WriteIOs uint64
+	}
+)
+
+// Stats returns current resources usage of a given cgroup.
+// Stats of controllers which are not enabled for the group are left empty.
+func Stats(groupPath string) (GroupStats, error) {
+	if err := ValidateGroupPath(groupPath); err != nil {
+		return GroupStats{}, err
+	}
+	if _, err := fs.Stat(groupPath); err != nil {
+		return GroupStats{}, err
+	}
+
+	var out GroupStats
+	cpuStat, err := readFlatKeyed(filepath.Join(groupPath, cpuStatFileName))
+	if err != nil {
+		return GroupStats{}, err
+	}
+	out.CPU = CPUStats{
+		UsageUsec:     cpuStat["usage_usec"],
+		UserUsec:      cpuStat["user_usec"],
+		SystemUsec:    cpuStat["system_usec"],
+		NrPeriods:     cpuStat["nr_periods"],
+		NrThrottled:   cpuStat["nr_throttled"],
+		ThrottledUsec: cpuStat["throttled_usec"],
+	}
+
+	if out.Memory.Current, err = readSingleValue(filepath.Join(groupPath, memoryCurrentFileName)); err != nil {
+		return GroupStats{}, err
+	}
+	if out.Memory.Peak, err = readSingleValue(filepath.Join(groupPath, memoryPeakFileName)); err != nil {
+		return GroupStats{}, err
+	}
+
+	events, err := readFlatKeyed(filepath.Join(groupPath, memoryEventsFileName))
+	if err != nil {
+		return GroupStats{}, err
+	}
+	out.Memory.Events = MemoryEvents{
+		Low:     events["low"],
+		High:    events["high"],
+		Max:     events["max"],
+		OOM:     events["oom"],
+		OOMKill: events["oom_kill"],
+	}
+
+	if out.IO, err = readIOStat(filepath.Join(groupPath, ioStatFileName)); err != nil {
+		return GroupStats{}, err
+	}
+
+	return out, nil
+}
+
+// ParseIOStatEntry parses a single "io.stat" line, e.g. "8:16 rbytes=1459200 wbytes=314773504 rios=192 wios=353".
+// Unknown keys are ignored.
+func ParseIOStatEntry(in string) (IOStat, error) {
+	fields := strings.Fields(in)
+	if len(fields) == 0 {
+		return IOStat{}, fmt.Errorf("invalid io.stat entry %q: missing device", in)
+	}
+
+	var out IOStat
+	if _, err := fmt.Sscanf(fields[0], "%d:%d", &out.Major, &out.Minor); err != nil {
+		return IOStat{}, fmt.Errorf("invalid io.stat entry %q: device should be in MAJ:MIN format", in)
+	}
+
+	for _, field := range fields[1:] {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			return IOStat{}, fmt.Errorf("invalid io.stat entry %q: field %q should be in key=value format", in, field)
+		}
+		val, err := strconv.ParseUint(kv[1], 10, 64)
+		if err != nil {
+			return IOStat{}, fmt.Errorf("invalid io.stat entry %q: %w", in, err)
+		}
+
+		switch kv[0] {
+		case "rbytes":
+			out.ReadBytes = val
+		case "wbytes":
+			out.WriteBytes = val
+		case "rios":
+			out.ReadIOs = val
+		case "wios":
+			out.WriteIOs = val
+		}
+	}
+
+	return out, nil
+}
+
+// readFlatKeyed reads files in the "KEY VALUE" per line format. Returns empty map if file doesn't exist.
+func readFlatKeyed(path string) (map[string]uint64, error) {
+	data, err := readOptionalFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]uint64{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid %s entry %q: should be in KEY VALUE format", filepath.Base(path), scanner.Text())
+		}
+		val, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s entry %q: %w", filepath.Base(path), scanner.Text(), err)
+		}
+		out[fields[0]] = val
+	}
+	return out, scanner.Err()
+}
+
+// readSingleValue reads files with a single numeric value. Returns zero if file doesn't exist.
+func readSingleValue(path string) (uint64, error) {
+	data, err := readOptionalFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	raw := strings.TrimSpace(string(data))
+	if raw == "" {
+		return 0, nil
+	}
+
+	val, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value: %w", filepath.Base(path), err)
+	}
+	return val, nil
+}
+
+func readIOStat(path string) ([]IOStat, error) {
+	data, err := readOptionalFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []IOStat
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		entry, err := ParseIOStatEntry(scanner.Text())
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, entry)
+	}
+	return out, scanner.Err()
+}
+
+// readOptionalFile returns file content, or nil if file doesn't exist, e.g. controller is not enabled.
+func readOptionalFile(path string) ([]byte, error) {
+	data, err := afero.ReadFile(fs, path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return data, nil
+}
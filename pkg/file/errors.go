@@ -19,3 +19,21 @@ func (e ConflictError) Error() string {
 
 // Conflict implements behavior error interface.
 func (e ConflictError) Conflict() {}
+
+// InvalidInputError represents an error indicating that the requested read options are not valid.
+type InvalidInputError struct {
+	reason string
+}
+
+// NewInvalidInputError returns a new InvalidInputError instance.
+func NewInvalidInputError(format string, args ...interface{}) *InvalidInputError {
+	return &InvalidInputError{reason: fmt.Sprintf(format, args...)}
+}
+
+// Error returns error message.
+func (e InvalidInputError) Error() string {
+	return e.reason
+}
+
+// InvalidInput implements behavior error interface.
+func (e InvalidInputError) InvalidInput() {}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/fsnotify/fsnotify"
+	"github.com/hashicorp/go-multierror"
 	"github.com/spf13/afero"
 
 	"github.com/mszostok/job-runner/internal/shutdown"
@@ -19,7 +20,11 @@ import (
 // Ensure on compilation phase, that Logger implements shutdown.ShutdownableService.
 var _ shutdown.ShutdownableService = &Logger{}
 
-const filePerm = 0666
+const (
+	filePerm = 0666
+	// sinkDrainTimeout specifies how long a released sink waits for the output buffered in pipes.
+	sinkDrainTimeout = time.Second
+)
 
 // Logger provides functionality to stream and fetch logs via file.
 type Logger struct {
@@ -55,9 +60,9 @@ func NewLogger(opts ...Option) (*Logger, error) {
 
 type ReleaseSinkFn func() error
 
-// NewSink returns a new file sink.
+// NewSink returns a new file sink. Stdout and Stderr streams are stored in the same file, but they can be read separately.
 // It's up to the caller to release returned Sink when it's not needed anymore.
-func (l *Logger) NewSink(name string) (io.Writer, ReleaseSinkFn, error) {
+func (l *Logger) NewSink(name string) (*Sink, ReleaseSinkFn, error) {
 	path := l.dst(name)
 	f, err := l.filesystem.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, filePerm)
 	if err != nil {
@@ -67,28 +72,101 @@ func (l *Logger) NewSink(name string) (io.Writer, ReleaseSinkFn, error) {
 		return nil, nil, errors.Wrap(err, "while opening file")
 	}
 
+	sink, err := newSink(f)
+	if err != nil {
+		_ = f.Close()
+		_ = l.filesystem.Remove(path)
+		return nil, nil, err
+	}
+
 	// fsnotify doesn't support close event: https://github.com/fsnotify/fsnotify/issues/22
 	closeSink := make(chan struct{})
 	l.activeSinks.Store(path, closeSink)
 	release := func() error {
+		var result *multierror.Error
+		if err := sink.close(sinkDrainTimeout); err != nil {
+			result = multierror.Append(result, err)
+		}
 		close(closeSink)
 		l.activeSinks.LoadAndDelete(path)
-		return f.Close()
+		if err := f.Close(); err != nil {
+			result = multierror.Append(result, err)
+		}
+		return result.ErrorOrNil()
 	}
-	return f, release, nil
+	return sink, release, nil
 }
 
-// ReadAndFollow reads Job logs and if log file is still in use, start watching it for a new entries.
-func (l *Logger) ReadAndFollow(ctx context.Context, name string) (<-chan []byte, <-chan error, error) {
+// Remove removes the log file of a given Job. It returns nil if the file doesn't exist.
+// The sink of a given Job must be already released.
+func (l *Logger) Remove(name string) error {
+	path := l.dst(name)
+	if _, active := l.activeSinks.Load(path); active {
+		return errors.Newf("log file of Job %q is still in use", name)
+	}
+
+	if err := l.filesystem.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return errors.Wrap(err, "while removing file")
+	}
+	return nil
+}
+
+// ReadOptions holds options for reading Job logs.
+type ReadOptions struct {
+	// Streams specifies which streams should be returned. If not specified, all streams are returned.
+	Streams []Stream
+	// Follow specifies whether to watch the log file for new entries as long as it's in use.
+	Follow bool
+	// TailLines specifies the number of lines from the end of the logs to return. If not specified, all lines are returned.
+	TailLines int64
+	// SinceOffset specifies the log file byte offset from which to start reading, e.g. the Chunk.Offset returned previously.
+	SinceOffset int64
+	// LimitBytes specifies the maximum number of bytes to return. If not specified, there is no limit.
+	LimitBytes int64
+	// FromEnd specifies whether to skip already stored entries, so only new ones are returned. It's useful only with Follow.
+	FromEnd bool
+}
+
+// Validate returns an error if options are not valid.
+func (o ReadOptions) Validate() error {
+	switch {
+	case o.TailLines < 0:
+		return NewInvalidInputError("tail lines cannot be negative")
+	case o.SinceOffset < 0:
+		return NewInvalidInputError("since offset cannot be negative")
+	case o.LimitBytes < 0:
+		return NewInvalidInputError("limit bytes cannot be negative")
+	case o.TailLines > 0 && o.SinceOffset > 0:
+		return NewInvalidInputError("tail lines and since offset are mutually exclusive")
+	case o.FromEnd && (o.TailLines > 0 || o.SinceOffset > 0):
+		return NewInvalidInputError("from end cannot be used together with tail lines or since offset")
+	}
+	return nil
+}
+
+// ReadAndFollow reads Job logs and if log file is still in use and follow was requested, start watching it for a new entries.
+func (l *Logger) ReadAndFollow(ctx context.Context, name string, opts ReadOptions) (<-chan Chunk, <-chan error, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, nil, err
+	}
+
 	path := l.dst(name)
 	file, err := l.OpenWithReadDeadliner(path)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "while opening log file")
 	}
 
+	start, err := l.seekStart(file, opts)
+	if err != nil {
+		_ = file.Close()
+		return nil, nil, err
+	}
+
 	var (
-		output = make(chan []byte)
+		output = make(chan Chunk)
 		issues = make(chan error)
+		done   = make(chan struct{})
+		reader = newRecordReader(file, l.readBufferSize, opts, start)
 	)
 
 	cleanup := func() {
@@ -96,6 +174,7 @@ func (l *Logger) ReadAndFollow(ctx context.Context, name string) (<-chan []byte,
 			issues <- err
 		}
 
+		close(done)
 		close(issues)
 		close(output)
 	}
@@ -106,7 +185,7 @@ func (l *Logger) ReadAndFollow(ctx context.Context, name string) (<-chan []byte,
 			_ = file.SetReadDeadline(time.Now()) // release currently-blocked Read call
 			// TODO: log error, we cannot push it to `issues` without proper synchronization and ensuring that
 			// channel is still open.
-		case <-output:
+		case <-done:
 			// nop, just release the goroutine
 		}
 	}()
@@ -114,9 +193,26 @@ func (l *Logger) ReadAndFollow(ctx context.Context, name string) (<-chan []byte,
 	go func() {
 		defer cleanup()
 
+		// drain sends new data and reports whether streaming should be finished.
+		drain := func() bool {
+			err := reader.drainIgnoreEOF(output)
+			switch {
+			case err == nil:
+				return false
+			case errors.Is(err, errLimitReached):
+				issues <- io.EOF
+			default:
+				issues <- err
+			}
+			return true
+		}
+
 		// 1. Drain file till EOF.
-		if err := l.drainFileIgnoreEOF(file, output); err != nil {
-			issues <- err
+		if drain() {
+			return
+		}
+		if !opts.Follow {
+			issues <- io.EOF
 			return
 		}
 
@@ -152,8 +248,7 @@ func (l *Logger) ReadAndFollow(ctx context.Context, name string) (<-chan []byte,
 		for {
 			select {
 			case <-closedSinkNotify: // Job finished and released log file. We need it as the `fsnotify` library doesn't support `Close` event
-				if err := l.drainFileIgnoreEOF(file, output); err != nil {
-					issues <- err
+				if drain() {
 					return
 				}
 				issues <- io.EOF
@@ -167,8 +262,7 @@ func (l *Logger) ReadAndFollow(ctx context.Context, name string) (<-chan []byte,
 				}
 				switch event {
 				case fsnotify.Write:
-					if err := l.drainFileIgnoreEOF(file, output); err != nil {
-						issues <- err
+					if drain() {
 						return
 					}
 				case fsnotify.Remove, fsnotify.Rename:
@@ -196,9 +290,29 @@ func (l *Logger) dst(name string) string {
 	return filepath.Join(l.logsDir, name)
 }
 
+// seekStart moves the file offset to the position from which logs should be read.
+func (l *Logger) seekStart(file ReadCloseDeadliner, opts ReadOptions) (startPosition, error) {
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return startPosition{}, errors.Wrap(err, "while getting log file size")
+	}
+
+	start, err := resolveStart(file, size, l.readBufferSize, opts)
+	if err != nil {
+		return startPosition{}, err
+	}
+
+	if _, err := file.Seek(start.offset, io.SeekStart); err != nil {
+		return startPosition{}, errors.Wrap(err, "while seeking log file")
+	}
+	return start, nil
+}
+
 // ReadCloseDeadliner represents a file in the filesystem.
 type ReadCloseDeadliner interface {
 	io.ReadCloser
+	io.Seeker
+	io.ReaderAt
 
 	// SetReadDeadline sets the deadline for future Read calls and any
 	// currently-blocked Read call.
@@ -219,23 +333,3 @@ func (l *Logger) OpenWithReadDeadliner(path string) (ReadCloseDeadliner, error)
 
 	return out, nil
 }
-
-func (l *Logger) drainFileIgnoreEOF(file io.Reader, sink chan<- []byte) error {
-	buff := make([]byte, l.readBufferSize)
-
-	for {
-		n, err := file.Read(buff)
-		// Even if error occurred, read may already load data into buffer.
-		out := make([]byte, n)
-		copy(out, buff[:n])
-		sink <- out
-
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				// This EOF is ignored, as later we want to watch this file for changes.
-				return nil
-			}
-			return errors.Wrap(err, "while reading log file")
-		}
-	}
-}
@@ -0,0 +1,207 @@
+package file_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mszostok/job-runner/pkg/file"
+)
+
+// logFile holds the following output:
+//
+//	stdout: "line1\nline2\nline3\n"
+//	stderr: "err1\n"
+const logFile = "stdout F line1\n" + // 0-14
+	"stderr F err1\n" + // 15-28
+	"stdout P li\n" + // 29-40
+	"stdout F ne2\n" + // 41-53
+	"stdout F line3\n" // 54-68
+
+func TestLoggerReadOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     file.ReadOptions
+		expected []file.Chunk
+	}{
+		{
+			name: "all logs",
+			expected: []file.Chunk{
+				{Stream: file.Stdout, Data: []byte("line1\n"), Offset: 15},
+				{Stream: file.Stderr, Data: []byte("err1\n"), Offset: 29},
+				{Stream: file.Stdout, Data: []byte("line2\nline3\n"), Offset: 69},
+			},
+		},
+		{
+			name: "tail lines",
+			opts: file.ReadOptions{TailLines: 2},
+			expected: []file.Chunk{
+				{Stream: file.Stdout, Data: []byte("line2\nline3\n"), Offset: 69},
+			},
+		},
+		{
+			name: "tail lines of a given stream",
+			opts: file.ReadOptions{TailLines: 1, Streams: []file.Stream{file.Stderr}},
+			expected: []file.Chunk{
+				{Stream: file.Stderr, Data: []byte("err1\n"), Offset: 29},
+			},
+		},
+		{
+			name: "tail more lines than available",
+			opts: file.ReadOptions{TailLines: 10, Streams: []file.Stream{file.Stdout}},
+			expected: []file.Chunk{
+				{Stream: file.Stdout, Data: []byte("line1\nline2\nline3\n"), Offset: 69},
+			},
+		},
+		{
+			name: "since offset in the middle of record",
+			opts: file.ReadOptions{SinceOffset: 51},
+			expected: []file.Chunk{
+				{Stream: file.Stdout, Data: []byte("e2\nline3\n"), Offset: 69},
+			},
+		},
+		{
+			name: "since offset at the end",
+			opts: file.ReadOptions{SinceOffset: 69},
+		},
+		{
+			name: "from end",
+			opts: file.ReadOptions{FromEnd: true},
+		},
+		{
+			name: "limit bytes",
+			opts: file.ReadOptions{LimitBytes: 8},
+			expected: []file.Chunk{
+				{Stream: file.Stdout, Data: []byte("line1\n"), Offset: 15},
+				{Stream: file.Stderr, Data: []byte("er"), Offset: 26},
+			},
+		},
+		{
+			name: "resume after limit bytes",
+			opts: file.ReadOptions{SinceOffset: 26},
+			expected: []file.Chunk{
+				{Stream: file.Stderr, Data: []byte("r1\n"), Offset: 29},
+				{Stream: file.Stdout, Data: []byte("line2\nline3\n"), Offset: 69},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			logger := newTestLogger(t)
+
+			// when
+			output, issues, err := logger.ReadAndFollow(context.Background(), "job", tc.opts)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, collectChunks(t, output, issues))
+		})
+	}
+}
+
+func TestLoggerReadOptionsFailures(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        file.ReadOptions
+		expectedErr string
+	}{
+		{
+			name:        "tail lines with since offset",
+			opts:        file.ReadOptions{TailLines: 1, SinceOffset: 10},
+			expectedErr: "tail lines and since offset are mutually exclusive",
+		},
+		{
+			name:        "from end with tail lines",
+			opts:        file.ReadOptions{FromEnd: true, TailLines: 1},
+			expectedErr: "from end cannot be used together with tail lines or since offset",
+		},
+		{
+			name:        "negative limit",
+			opts:        file.ReadOptions{LimitBytes: -1},
+			expectedErr: "limit bytes cannot be negative",
+		},
+		{
+			name:        "since offset after the end",
+			opts:        file.ReadOptions{SinceOffset: 70},
+			expectedErr: "since offset 70 is greater than the log size 69",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			logger := newTestLogger(t)
+
+			// when
+			_, _, err := logger.ReadAndFollow(context.Background(), "job", tc.opts)
+
+			// then
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestLoggerRemove(t *testing.T) {
+	// given
+	logger := newTestLogger(t)
+
+	_, release, err := logger.NewSink("active")
+	require.NoError(t, err)
+
+	// when
+	err = logger.Remove("active")
+
+	// then
+	assert.EqualError(t, err, `log file of Job "active" is still in use`)
+
+	// when
+	require.NoError(t, release())
+	err = logger.Remove("active")
+
+	// then
+	require.NoError(t, err)
+	_, release, err = logger.NewSink("active")
+	require.NoError(t, err, "name of the removed log file should be reusable")
+	assert.NoError(t, release())
+
+	// when
+	err = logger.Remove("missing")
+
+	// then
+	assert.NoError(t, err)
+}
+
+func newTestLogger(t *testing.T) *file.Logger {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "job"), []byte(logFile), 0600))
+
+	logger, err := file.NewLogger(file.WithLogsDir(dir))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, logger.Shutdown())
+	})
+
+	return logger
+}
+
+func collectChunks(t *testing.T, output <-chan file.Chunk, issues <-chan error) []file.Chunk {
+	t.Helper()
+
+	var out []file.Chunk
+	for {
+		select {
+		case chunk := <-output:
+			out = append(out, chunk)
+		case err := <-issues:
+			require.ErrorIs(t, err, io.EOF)
+			return out
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package file
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/cockroachdb/errors"
+)
+
+// recordHeaderSize is the size of the "<stream> <tag> " record prefix. All stream names have the same length.
+var recordHeaderSize = int64(len(string(Stdout) + " F "))
+
+// startPosition specifies where reading the log file starts.
+type startPosition struct {
+	// offset is the log file offset of the first record to read.
+	offset int64
+	// skip is the number of output bytes to skip from the first record.
+	skip int64
+}
+
+// resolveStart returns the position from which the log file should be read.
+// It seeks only over the records that are needed to find the position, so it's cheap also for large log files.
+func resolveStart(r io.ReaderAt, size int64, buffSize int, opts ReadOptions) (startPosition, error) {
+	buff := make([]byte, buffSize)
+
+	switch {
+	case opts.FromEnd:
+		return startPosition{offset: size}, nil
+	case opts.TailLines > 0:
+		offset, err := tailStart(r, size, buff, opts)
+		if err != nil {
+			return startPosition{}, err
+		}
+		return startPosition{offset: offset}, nil
+	case opts.SinceOffset > 0:
+		if opts.SinceOffset > size {
+			return startPosition{}, NewInvalidInputError("since offset %d is greater than the log size %d", opts.SinceOffset, size)
+		}
+		return sinceStart(r, size, buff, opts.SinceOffset)
+	default:
+		return startPosition{}, nil
+	}
+}
+
+// tailStart returns the offset of the first record that belongs to the last opts.TailLines lines of requested streams.
+func tailStart(r io.ReaderAt, size int64, buff []byte, opts ReadOptions) (int64, error) {
+	lastNewline, err := lastNewlineBefore(r, size, buff)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		pos   = lastNewline + 1 // end of the last complete record, the incomplete one is always included
+		lines int64
+	)
+	for pos > 0 {
+		prevNewline, err := lastNewlineBefore(r, pos-1, buff)
+		if err != nil {
+			return 0, err
+		}
+
+		start := prevNewline + 1
+		rec, err := readRecordHeader(r, start, pos-1)
+		if err != nil {
+			return 0, err
+		}
+
+		if rec.full && contains(opts.Streams, rec.stream) {
+			lines++
+			if lines > opts.TailLines {
+				return pos, nil
+			}
+		}
+		pos = start
+	}
+
+	return 0, nil
+}
+
+// sinceStart returns the position of a given offset. If the offset points in the middle of a record,
+// reading starts from that record, but the already returned part of its content is skipped.
+func sinceStart(r io.ReaderAt, size int64, buff []byte, offset int64) (startPosition, error) {
+	prevNewline, err := lastNewlineBefore(r, offset, buff)
+	if err != nil {
+		return startPosition{}, err
+	}
+
+	start := prevNewline + 1
+	if start == offset {
+		return startPosition{offset: start}, nil
+	}
+
+	if _, err := readRecordHeader(r, start, size); err != nil {
+		return startPosition{}, err
+	}
+
+	skip := offset - (start + recordHeaderSize)
+	if skip < 0 {
+		skip = 0
+	}
+	return startPosition{offset: start, skip: skip}, nil
+}
+
+// readRecordHeader reads the header of the record that starts at a given offset.
+func readRecordHeader(r io.ReaderAt, start, limit int64) (record, error) {
+	size := recordHeaderSize
+	if limit-start < size {
+		size = limit - start
+	}
+
+	header := make([]byte, size)
+	if _, err := r.ReadAt(header, start); err != nil && !errors.Is(err, io.EOF) {
+		return record{}, errors.Wrap(err, "while reading log record")
+	}
+	return parseRecord(header)
+}
+
+// lastNewlineBefore returns the offset of the last newline placed before a given offset, or -1 if there is no such newline.
+func lastNewlineBefore(r io.ReaderAt, offset int64, buff []byte) (int64, error) {
+	for offset > 0 {
+		n := int64(len(buff))
+		if n > offset {
+			n = offset
+		}
+
+		chunk := buff[:n]
+		if _, err := r.ReadAt(chunk, offset-n); err != nil && !errors.Is(err, io.EOF) {
+			return 0, errors.Wrap(err, "while reading log file")
+		}
+		if idx := bytes.LastIndexByte(chunk, '\n'); idx >= 0 {
+			return offset - n + int64(idx), nil
+		}
+		offset -= n
+	}
+
+	return -1, nil
+}
@@ -0,0 +1,323 @@
+package file
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Stream represents the process output stream.
+type Stream string
+
+const (
+	// Stdout represents the standard output stream.
+	Stdout Stream = "stdout"
+	// Stderr represents the standard error stream.
+	Stderr Stream = "stderr"
+)
+
+// The process output is stored as newline-delimited records tagged with the stream that produced them.
+// The format is similar to the one used by CRI:
+//
+//	<stream> <tag> <content>\n
+//
+// Tag is "F" if content is a full line, or "P" if the line is continued in one of the next records.
+// Content never contains a newline, so for full lines the record delimiter is also the line ending.
+const (
+	fullLineTag    = 'F'
+	partialLineTag = 'P'
+)
+
+// Chunk represents a part of the process output read from the log file.
+type Chunk struct {
+	// Stream specifies the stream that produced the chunk.
+	Stream Stream
+	// Data holds the chunk content.
+	Data []byte
+	// Offset specifies the log file byte offset right after the chunk. It can be used to resume reading.
+	Offset int64
+}
+
+// Sink holds pipes for process output streams. Data written to them is multiplexed into a single log file.
+// Pipes are used instead of plain writers, so exec.Cmd.Wait doesn't wait for processes that inherited
+// the output streams and outlived the Job's main process.
+type Sink struct {
+	Stdout *os.File
+	Stderr *os.File
+
+	readers []*os.File
+	copied  sync.WaitGroup
+}
+
+func newSink(w io.Writer) (*Sink, error) {
+	var (
+		mu   = &sync.Mutex{}
+		sink = &Sink{}
+	)
+	for _, stream := range []Stream{Stdout, Stderr} {
+		r, pw, err := os.Pipe()
+		if err != nil {
+			_ = sink.close(0)
+			return nil, errors.Wrapf(err, "while creating %s pipe", stream)
+		}
+
+		sink.readers = append(sink.readers, r)
+		if stream == Stdout {
+			sink.Stdout = pw
+		} else {
+			sink.Stderr = pw
+		}
+
+		sink.copied.Add(1)
+		go func(stream Stream) {
+			defer sink.copied.Done()
+			_, _ = io.Copy(&streamWriter{stream: stream, mu: mu, w: w}, r)
+		}(stream)
+	}
+
+	return sink, nil
+}
+
+// close closes the Sink pipes and waits until the buffered output is copied to the log file.
+// If pipes are still held by other processes, copying is interrupted after drainTimeout.
+func (s *Sink) close(drainTimeout time.Duration) error {
+	var result *multierror.Error
+	for _, f := range []*os.File{s.Stdout, s.Stderr} {
+		if f == nil {
+			continue
+		}
+		if err := f.Close(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	copied := make(chan struct{})
+	go func() {
+		s.copied.Wait()
+		close(copied)
+	}()
+	select {
+	case <-copied:
+	case <-time.After(drainTimeout):
+	}
+
+	// Release copying goroutines if pipes are still in use.
+	for _, r := range s.readers {
+		if err := r.Close(); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	<-copied
+
+	return result.ErrorOrNil()
+}
+
+// streamWriter converts written data into records. Records are written at once, so they are never interleaved.
+type streamWriter struct {
+	stream Stream
+	mu     *sync.Mutex
+	w      io.Writer
+}
+
+func (s *streamWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	var (
+		records bytes.Buffer
+		rest    = p
+	)
+	for len(rest) > 0 {
+		tag, line := byte(partialLineTag), rest
+		if idx := bytes.IndexByte(rest, '\n'); idx >= 0 {
+			tag, line = fullLineTag, rest[:idx]
+			rest = rest[idx+1:]
+		} else {
+			rest = nil
+		}
+
+		records.WriteString(string(s.stream))
+		records.WriteByte(' ')
+		records.WriteByte(tag)
+		records.WriteByte(' ')
+		records.Write(line)
+		records.WriteByte('\n')
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.w.Write(records.Bytes()); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// record represents a single parsed log file record.
+type record struct {
+	stream  Stream
+	full    bool
+	content []byte
+}
+
+func parseRecord(raw []byte) (record, error) {
+	parts := bytes.SplitN(raw, []byte{' '}, 3)
+	if len(parts) != 3 || len(parts[1]) != 1 {
+		return record{}, errors.Newf("malformed log file: invalid record %q", raw)
+	}
+
+	stream := Stream(parts[0])
+	if stream != Stdout && stream != Stderr {
+		return record{}, errors.Newf("malformed log file: unknown stream %q", stream)
+	}
+
+	switch parts[1][0] {
+	case fullLineTag:
+		return record{stream: stream, full: true, content: parts[2]}, nil
+	case partialLineTag:
+		return record{stream: stream, content: parts[2]}, nil
+	default:
+		return record{}, errors.Newf("malformed log file: unknown tag %q", parts[1])
+	}
+}
+
+// errLimitReached is returned when the requested number of bytes was already read.
+var errLimitReached = errors.New("log bytes limit reached")
+
+// recordReader reads records from the log file. Incomplete records are kept until the rest is written.
+type recordReader struct {
+	r       io.Reader
+	buff    []byte
+	pending []byte
+	streams []Stream
+
+	// offset is the log file offset of the first pending byte.
+	offset int64
+	// skip is the number of output bytes to skip from the first record.
+	skip int64
+	// remaining is the number of output bytes that can still be sent. Negative value means no limit.
+	remaining int64
+}
+
+func newRecordReader(r io.Reader, buffSize int, opts ReadOptions, start startPosition) *recordReader {
+	remaining := opts.LimitBytes
+	if remaining == 0 {
+		remaining = -1
+	}
+	return &recordReader{
+		r:         r,
+		buff:      make([]byte, buffSize),
+		streams:   opts.Streams,
+		offset:    start.offset,
+		skip:      start.skip,
+		remaining: remaining,
+	}
+}
+
+// drainIgnoreEOF reads the underlying file till EOF and sends the content of all complete records from requested streams.
+// It returns errLimitReached if there is nothing more to send because of the bytes limit.
+func (f *recordReader) drainIgnoreEOF(sink chan<- Chunk) error {
+	for {
+		n, err := f.r.Read(f.buff)
+		// Even if error occurred, read may already load data into buffer.
+		f.pending = append(f.pending, f.buff[:n]...)
+		if rerr := f.sendCompleteRecords(sink); rerr != nil {
+			return rerr
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				// This EOF is ignored, as later we want to watch this file for changes.
+				return nil
+			}
+			return errors.Wrap(err, "while reading log file")
+		}
+	}
+}
+
+// sendCompleteRecords sends the content of complete records. Consecutive records from the same stream are sent as one Chunk.
+func (f *recordReader) sendCompleteRecords(sink chan<- Chunk) error {
+	var out *Chunk
+	flush := func() {
+		if out != nil {
+			sink <- *out
+			out = nil
+		}
+	}
+	defer flush()
+
+	for f.remaining != 0 {
+		idx := bytes.IndexByte(f.pending, '\n')
+		if idx < 0 {
+			return nil // wait for the rest of the record
+		}
+
+		rec, err := parseRecord(f.pending[:idx])
+		if err != nil {
+			return err
+		}
+
+		recordEnd := f.offset + int64(idx) + 1
+		if contains(f.streams, rec.stream) {
+			// For full lines, the record delimiter is also the line ending.
+			contentStart := idx - len(rec.content)
+			data := f.pending[contentStart:idx]
+			if rec.full {
+				data = f.pending[contentStart : idx+1]
+			}
+			dataOffset := f.offset + int64(contentStart)
+
+			if f.skip > 0 {
+				skip := f.skip
+				if skip > int64(len(data)) {
+					skip = int64(len(data))
+				}
+				data, dataOffset = data[skip:], dataOffset+skip
+			}
+
+			next := recordEnd
+			if f.remaining > 0 {
+				if int64(len(data)) > f.remaining {
+					data, next = data[:f.remaining], dataOffset+f.remaining
+				}
+				f.remaining -= int64(len(data))
+			}
+
+			if len(data) > 0 {
+				if out != nil && out.Stream != rec.stream {
+					flush()
+				}
+				if out == nil {
+					out = &Chunk{Stream: rec.stream}
+				}
+				out.Data = append(out.Data, data...)
+				out.Offset = next
+			}
+		}
+
+		f.skip = 0
+		f.pending = f.pending[idx+1:]
+		f.offset = recordEnd
+	}
+
+	return errLimitReached
+}
+
+// contains returns true if a given stream is on the list. Empty list means all streams.
+func contains(streams []Stream, stream Stream) bool {
+	if len(streams) == 0 {
+		return true
+	}
+	for _, s := range streams {
+		if s == stream {
+			return true
+		}
+	}
+	return false
+}
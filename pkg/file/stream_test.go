@@ -0,0 +1,112 @@
+package file
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSinkStreamsDemultiplexing(t *testing.T) {
+	tests := []struct {
+		name     string
+		streams  []Stream
+		expected []Chunk
+	}{
+		{
+			name: "all streams",
+			expected: []Chunk{
+				{Stream: Stdout, Data: []byte("hakuna\n"), Offset: 16},
+				{Stream: Stderr, Data: []byte("err"), Offset: 29},
+				{Stream: Stderr, Data: []byte("or\n"), Offset: 41},
+				{Stream: Stdout, Data: []byte("matata\n"), Offset: 57},
+			},
+		},
+		{
+			name:    "only stdout",
+			streams: []Stream{Stdout},
+			expected: []Chunk{
+				{Stream: Stdout, Data: []byte("hakuna\n"), Offset: 16},
+				{Stream: Stdout, Data: []byte("matata\n"), Offset: 57},
+			},
+		},
+		{
+			name:    "only stderr",
+			streams: []Stream{Stderr},
+			expected: []Chunk{
+				{Stream: Stderr, Data: []byte("err"), Offset: 29},
+				{Stream: Stderr, Data: []byte("or\n"), Offset: 41},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var (
+				file   bytes.Buffer
+				mu     sync.Mutex
+				stdout = &streamWriter{stream: Stdout, mu: &mu, w: &file}
+				stderr = &streamWriter{stream: Stderr, mu: &mu, w: &file}
+			)
+
+			_, err := stdout.Write([]byte("hakuna\n"))
+			require.NoError(t, err)
+			_, err = stderr.Write([]byte("err"))
+			require.NoError(t, err)
+			_, err = stderr.Write([]byte("or\n"))
+			require.NoError(t, err)
+			_, err = stdout.Write([]byte("matata\n"))
+			require.NoError(t, err)
+
+			// Use a small buffer to ensure that records split across reads are handled.
+			reader := newRecordReader(&file, 3, ReadOptions{Streams: tc.streams}, startPosition{})
+			out := make(chan Chunk, len(tc.expected)+1)
+
+			// when
+			err = reader.drainIgnoreEOF(out)
+			close(out)
+
+			// then
+			require.NoError(t, err)
+			var got []Chunk
+			for chunk := range out {
+				got = append(got, chunk)
+			}
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRecordReaderMalformedFile(t *testing.T) {
+	// given
+	reader := newRecordReader(bytes.NewBufferString("plain text written without records\n"), 4096, ReadOptions{}, startPosition{})
+
+	// when
+	err := reader.drainIgnoreEOF(make(chan Chunk, 1))
+
+	// then
+	assert.EqualError(t, err, `malformed log file: invalid record "plain text written without records"`)
+}
+
+func TestSinkCopiesBufferedOutputOnClose(t *testing.T) {
+	// given
+	var file bytes.Buffer
+	sink, err := newSink(&file)
+	require.NoError(t, err)
+
+	_, err = sink.Stdout.WriteString("hakuna\nmata")
+	require.NoError(t, err)
+	_, err = sink.Stderr.WriteString("error\n")
+	require.NoError(t, err)
+
+	// when
+	err = sink.close(time.Second)
+
+	// then
+	require.NoError(t, err)
+	assert.Contains(t, file.String(), "stdout F hakuna\nstdout P mata\n")
+	assert.Contains(t, file.String(), "stderr F error\n")
+}
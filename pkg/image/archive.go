@@ -0,0 +1,426 @@
+package image
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// whiteoutPrefix marks files removed by the OCI image layer.
+	whiteoutPrefix = ".wh."
+	// opaqueWhiteout marks directory whose content from lower OCI image layers is removed.
+	opaqueWhiteout = whiteoutPrefix + whiteoutPrefix + ".opq"
+
+	ociLayoutFileName = "oci-layout"
+	ociIndexFileName  = "index.json"
+	// maxIndexDepth limits how many nested indexes are followed to find the image manifest.
+	maxIndexDepth = 4
+	rootfsDirPerm = 0755
+)
+
+var (
+	digestRegexp = regexp.MustCompile(`^([a-z0-9]+):([a-f0-9]{32,})$`)
+	gzipMagic    = []byte{0x1f, 0x8b}
+)
+
+// SecureJoin joins root with a given name, which is always resolved inside root. It returns error if any existing
+// parent directory of the result is a symbolic link, so following links never leads outside root.
+func SecureJoin(root, name string) (string, error) {
+	clean := filepath.Clean(string(filepath.Separator) + name)
+	parts := strings.Split(clean, string(filepath.Separator))
+
+	current := root
+	for _, part := range parts[1 : len(parts)-1] {
+		current = filepath.Join(current, part)
+		info, err := os.Lstat(current)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			return filepath.Join(root, clean), nil
+		case err != nil:
+			return "", err
+		case info.Mode()&os.ModeSymlink != 0:
+			return "", NewInvalidInputError("path %q goes through symbolic link %q", name, strings.TrimPrefix(current, root))
+		}
+	}
+	return filepath.Join(root, clean), nil
+}
+
+// unpackImage unpacks a given archive to the rootfs directory. If the archive is an OCI image layout,
+// it's unpacked to the work directory first, and then its layers are applied to rootfs in order.
+func unpackImage(archive io.Reader, workDir, rootfs string, q *quota) error {
+	layout := filepath.Join(workDir, "layout")
+	if err := mkdirWithPerm(layout, rootfsDirPerm); err != nil {
+		return err
+	}
+	if err := unpack(archive, layout, false, q); err != nil {
+		return errors.Wrap(err, "while unpacking archive")
+	}
+
+	if _, err := os.Lstat(filepath.Join(layout, ociLayoutFileName)); errors.Is(err, os.ErrNotExist) {
+		// the archive holds the root filesystem itself
+		return os.Rename(layout, rootfs)
+	}
+
+	layers, err := ociLayers(layout)
+	if err != nil {
+		return errors.Wrap(err, "while reading OCI image layout")
+	}
+	if err := mkdirWithPerm(rootfs, rootfsDirPerm); err != nil {
+		return err
+	}
+	for _, layer := range layers {
+		if err := applyLayer(layout, rootfs, layer, q); err != nil {
+			return errors.Wrapf(err, "while applying layer %q", layer.Digest)
+		}
+	}
+	return os.RemoveAll(layout)
+}
+
+// unpack unpacks a given tar archive, optionally compressed with gzip, to the dst directory.
+// If the archive is an OCI image layer, its whiteouts remove files unpacked from lower layers.
+func unpack(archive io.Reader, dst string, layer bool, q *quota) error {
+	buffered := bufio.NewReader(archive)
+	var src io.Reader = buffered
+	if magic, err := buffered.Peek(len(gzipMagic)); err == nil && string(magic) == string(gzipMagic) {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return NewInvalidInputError("invalid gzip archive: %v", err)
+		}
+		defer gz.Close()
+		src = gz
+	}
+
+	// unpacked holds paths from this archive, so the opaque whiteout removes only files from lower layers
+	unpacked := map[string]struct{}{}
+	tr := tar.NewReader(src)
+	for {
+		hdr, err := tr.Next()
+		switch {
+		case err == io.EOF:
+			return nil
+		case errors.Is(err, tar.ErrHeader), errors.Is(err, gzip.ErrHeader), errors.Is(err, gzip.ErrChecksum):
+			return NewInvalidInputError("invalid tar archive: %v", err)
+		case err != nil:
+			return err
+		}
+
+		if err := unpackEntry(tr, hdr, dst, layer, unpacked, q); err != nil {
+			return errors.Wrapf(err, "while unpacking %q", hdr.Name)
+		}
+	}
+}
+
+func unpackEntry(tr *tar.Reader, hdr *tar.Header, dst string, layer bool, unpacked map[string]struct{}, q *quota) error {
+	target, err := SecureJoin(dst, hdr.Name)
+	if err != nil {
+		return err
+	}
+
+	if layer {
+		base := filepath.Base(target)
+		switch {
+		case base == opaqueWhiteout:
+			return removeLowerEntries(filepath.Dir(target), unpacked)
+		case strings.HasPrefix(base, whiteoutPrefix):
+			return os.RemoveAll(filepath.Join(filepath.Dir(target), strings.TrimPrefix(base, whiteoutPrefix)))
+		}
+	}
+
+	if target != dst {
+		if err := os.MkdirAll(filepath.Dir(target), rootfsDirPerm); err != nil {
+			return err
+		}
+		// existing directories are merged, any other entry is replaced
+		if info, err := os.Lstat(target); err == nil && !(info.IsDir() && hdr.Typeflag == tar.TypeDir) {
+			if err := os.RemoveAll(target); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if err := os.Mkdir(target, rootfsDirPerm); err != nil && !errors.Is(err, os.ErrExist) {
+			return err
+		}
+	case tar.TypeReg, tar.TypeRegA: // TypeRegA is still produced by old archivers
+		if err := writeFile(target, tr, q); err != nil {
+			return err
+		}
+	case tar.TypeSymlink:
+		// the link is resolved only when the Job runs with the rootfs as its root directory
+		if err := os.Symlink(hdr.Linkname, target); err != nil {
+			return err
+		}
+	case tar.TypeLink:
+		src, err := SecureJoin(dst, hdr.Linkname)
+		if err != nil {
+			return err
+		}
+		// hard link shares ownership and mode with its source
+		unpacked[target] = struct{}{}
+		return os.Link(src, target)
+	case tar.TypeFifo:
+		if err := unix.Mkfifo(target, 0600); err != nil {
+			return err
+		}
+	default:
+		// devices are provided when the Job starts, other types don't carry files
+		return nil
+	}
+	unpacked[target] = struct{}{}
+
+	// ownership is preserved only if Agent can change it, otherwise files belong to the Agent's user
+	if os.Geteuid() == 0 {
+		if err := os.Lchown(target, hdr.Uid, hdr.Gid); err != nil {
+			return err
+		}
+	}
+	if hdr.Typeflag == tar.TypeSymlink {
+		return nil
+	}
+	// chmod is called after chown, as chown clears setuid and setgid bits. The bits are kept for completeness,
+	// but they are ignored when Jobs run, as the rootfs is mounted with nosuid.
+	mode := hdr.FileInfo().Mode() & (os.ModePerm | os.ModeSetuid | os.ModeSetgid | os.ModeSticky)
+	return os.Chmod(target, mode)
+}
+
+func writeFile(path string, content io.Reader, q *quota) error {
+	f, err := os.OpenFile(filepath.Clean(path), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if err := q.copy(f, content); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// quota limits the total size of unpacked files. Nil quota means no limit.
+type quota struct {
+	max  int64
+	used int64
+}
+
+// newQuota returns a quota of a given size in bytes. Zero means no limit, so nil is returned.
+func newQuota(max int64) *quota {
+	if max <= 0 {
+		return nil
+	}
+	return &quota{max: max}
+}
+
+// copy copies content to a given writer, and returns error once the quota is exceeded.
+func (q *quota) copy(dst io.Writer, content io.Reader) error {
+	if q == nil {
+		_, err := io.Copy(dst, content)
+		return err
+	}
+
+	// one more byte is read, so exceeding the quota is distinguished from filling it up
+	n, err := io.Copy(dst, io.LimitReader(content, q.max-q.used+1))
+	q.used += n
+	if err != nil {
+		return err
+	}
+	if q.used > q.max {
+		return NewInvalidInputError("unpacked image exceeds the limit of %d bytes", q.max)
+	}
+	return nil
+}
+
+// sizeLimitedReader returns error once more than max bytes were read from the underlying reader.
+type sizeLimitedReader struct {
+	r    io.Reader
+	max  int64
+	read int64
+}
+
+// newSizeLimitedReader returns reader limited to a given size in bytes. Zero means no limit, so r is returned as is.
+func newSizeLimitedReader(r io.Reader, max int64) io.Reader {
+	if max <= 0 {
+		return r
+	}
+	return &sizeLimitedReader{r: r, max: max}
+}
+
+func (l *sizeLimitedReader) Read(p []byte) (int, error) {
+	// one more byte is read, so exceeding the limit is distinguished from reaching it
+	if remaining := l.max - l.read + 1; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.max {
+		return 0, NewInvalidInputError("archive exceeds the limit of %d bytes", l.max)
+	}
+	return n, err
+}
+
+// removeLowerEntries removes content of a given directory which was not unpacked from the current layer.
+func removeLowerEntries(dir string, unpacked map[string]struct{}) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if _, found := unpacked[path]; found {
+			continue
+		}
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mkdirWithPerm(path string, perm os.FileMode) error {
+	if err := os.Mkdir(path, perm); err != nil {
+		return err
+	}
+	return os.Chmod(path, perm) // Mkdir is affected by umask
+}
+
+// ociDescriptor describes content in the OCI image layout.
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Platform  *struct {
+		Architecture string `json:"architecture"`
+		OS           string `json:"os"`
+	} `json:"platform,omitempty"`
+}
+
+type ociIndex struct {
+	Manifests []ociDescriptor `json:"manifests"`
+}
+
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+// ociLayers returns layers of the image from a given OCI image layout, the lowest first.
+// If the layout holds multiple images, the one for the Agent's platform is used.
+func ociLayers(layout string) ([]ociDescriptor, error) {
+	var index ociIndex
+	if err := readJSON(filepath.Join(layout, ociIndexFileName), &index); err != nil {
+		return nil, err
+	}
+
+	for depth := 0; depth < maxIndexDepth; depth++ {
+		desc, err := selectManifest(index.Manifests)
+		if err != nil {
+			return nil, err
+		}
+		blob, err := blobPath(layout, desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+
+		if !strings.Contains(desc.MediaType, "index") && !strings.Contains(desc.MediaType, "manifest.list") {
+			var manifest ociManifest
+			if err := readJSON(blob, &manifest); err != nil {
+				return nil, err
+			}
+			return manifest.Layers, nil
+		}
+
+		index = ociIndex{}
+		if err := readJSON(blob, &index); err != nil {
+			return nil, err
+		}
+	}
+	return nil, NewInvalidInputError("image manifest not found within %d nested indexes", maxIndexDepth)
+}
+
+// selectManifest returns the only manifest, or the one for the Agent's platform.
+func selectManifest(manifests []ociDescriptor) (ociDescriptor, error) {
+	if len(manifests) == 1 {
+		return manifests[0], nil
+	}
+	for _, desc := range manifests {
+		if desc.Platform != nil && desc.Platform.OS == "linux" && desc.Platform.Architecture == runtime.GOARCH {
+			return desc, nil
+		}
+	}
+	return ociDescriptor{}, NewInvalidInputError("image for linux/%s platform not found", runtime.GOARCH)
+}
+
+// applyLayer unpacks a given layer to rootfs. If the layer is stored with sha256 digest, its content is verified.
+func applyLayer(layout, rootfs string, layer ociDescriptor, q *quota) error {
+	if strings.Contains(layer.MediaType, "zstd") {
+		return NewInvalidInputError("layer media type %q is not supported", layer.MediaType)
+	}
+	blob, err := blobPath(layout, layer.Digest)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(filepath.Clean(blob))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var content io.Reader = f
+	hash := sha256.New()
+	if strings.HasPrefix(layer.Digest, "sha256:") {
+		content = io.TeeReader(f, hash)
+	}
+
+	if err := unpack(content, rootfs, true, q); err != nil {
+		return err
+	}
+	if content == f {
+		return nil
+	}
+
+	if _, err := io.Copy(io.Discard, content); err != nil { // hash also the tar padding
+		return err
+	}
+	if got := "sha256:" + hex.EncodeToString(hash.Sum(nil)); got != layer.Digest {
+		return NewInvalidInputError("layer digest mismatch, got %q", got)
+	}
+	return nil
+}
+
+// blobPath returns path of the blob with a given digest.
+func blobPath(layout, digest string) (string, error) {
+	parts := digestRegexp.FindStringSubmatch(digest)
+	if parts == nil {
+		return "", NewInvalidInputError("invalid digest %q", digest)
+	}
+	return filepath.Join(layout, "blobs", parts[1], parts[2]), nil
+}
+
+func readJSON(path string, out interface{}) error {
+	raw, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return NewInvalidInputError("%s not found", filepath.Base(path))
+		}
+		return err
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return NewInvalidInputError("invalid %s: %v", filepath.Base(path), err)
+	}
+	return nil
+}
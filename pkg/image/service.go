@@ -0,0 +1,288 @@
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	metadataFileName = "image.json"
+	rootfsDirName    = "rootfs"
+	// tmpDirPrefix is used for images being imported or deleted. Such directories are removed on Service start.
+	tmpDirPrefix     = ".tmp-"
+	dirPerm          = 0700
+	metadataFilePerm = 0600
+)
+
+// nameRegexp is used to validate image names. Names are used as directory names, so they cannot contain slashes.
+var nameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._:-]{0,127}$`)
+
+// Service manages root filesystem images stored in a given directory. Each image is kept in a dedicated
+// subdirectory with its metadata and, if it was imported from archive, the unpacked root filesystem.
+type Service struct {
+	dir          string
+	importLimits ImportLimits
+
+	mu     sync.Mutex
+	images map[string]Image
+	// importing holds names of images being imported, so the same name cannot be imported concurrently.
+	importing map[string]struct{}
+}
+
+// NewService returns a new Service instance. Images stored by previous instances are loaded, and leftovers
+// of interrupted imports are removed.
+func NewService(dir string, opts ...ServiceOption) (*Service, error) {
+	if err := os.MkdirAll(dir, dirPerm); err != nil {
+		return nil, errors.Wrap(err, "while creating images directory")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading images directory")
+	}
+
+	svc := &Service{
+		dir:       dir,
+		images:    map[string]Image{},
+		importing: map[string]struct{}{},
+	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if strings.HasPrefix(entry.Name(), tmpDirPrefix) {
+			if err := os.RemoveAll(path); err != nil {
+				return nil, errors.Wrapf(err, "while removing leftover %q", path)
+			}
+			continue
+		}
+		if !entry.IsDir() {
+			continue
+		}
+
+		img, err := loadMetadata(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while loading image from %q", path)
+		}
+		svc.images[img.Name] = img
+	}
+
+	return svc, nil
+}
+
+// Import unpacks a given archive as a new image. The image is available only once the whole archive is unpacked.
+// Archive exceeding the configured import limits is rejected.
+func (s *Service) Import(_ context.Context, in ImportInput) (_ *ImportOutput, err error) {
+	if err := validateName(in.Name); err != nil {
+		return nil, err
+	}
+	if err := s.reserve(in.Name); err != nil {
+		return nil, err
+	}
+	defer s.release(in.Name)
+
+	tmp, err := os.MkdirTemp(s.dir, tmpDirPrefix)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating temporary directory")
+	}
+	defer func() {
+		if err != nil {
+			_ = os.RemoveAll(tmp)
+		}
+	}()
+
+	archive := newSizeLimitedReader(in.Archive, s.importLimits.MaxArchiveSize)
+	if err := unpackImage(archive, tmp, filepath.Join(tmp, rootfsDirName), newQuota(s.importLimits.MaxUnpackedSize)); err != nil {
+		return nil, err
+	}
+
+	img := Image{
+		Name:      in.Name,
+		Tenant:    in.Tenant,
+		CreatedAt: time.Now(),
+	}
+	if err := s.commit(img, tmp); err != nil {
+		return nil, err
+	}
+	return &ImportOutput{}, nil
+}
+
+// Register adds a directory from the Agent's host as a new image. The directory is used in place, so its changes
+// are visible to Jobs started afterwards. Changes made by Jobs are not written to the directory.
+func (s *Service) Register(_ context.Context, in RegisterInput) (_ *RegisterOutput, err error) {
+	if err := validateName(in.Name); err != nil {
+		return nil, err
+	}
+	if !filepath.IsAbs(in.HostPath) {
+		return nil, NewInvalidInputError("host path %q must be an absolute path", in.HostPath)
+	}
+	info, err := os.Stat(in.HostPath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil, NewInvalidInputError("host path %q doesn't exist", in.HostPath)
+	case err != nil:
+		return nil, errors.Wrap(err, "while checking host path")
+	case !info.IsDir():
+		return nil, NewInvalidInputError("host path %q is not a directory", in.HostPath)
+	}
+
+	if err := s.reserve(in.Name); err != nil {
+		return nil, err
+	}
+	defer s.release(in.Name)
+
+	tmp, err := os.MkdirTemp(s.dir, tmpDirPrefix)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating temporary directory")
+	}
+	defer func() {
+		if err != nil {
+			_ = os.RemoveAll(tmp)
+		}
+	}()
+
+	img := Image{
+		Name:      in.Name,
+		Tenant:    in.Tenant,
+		CreatedAt: time.Now(),
+		HostPath:  filepath.Clean(in.HostPath),
+	}
+	if err := s.commit(img, tmp); err != nil {
+		return nil, err
+	}
+	return &RegisterOutput{}, nil
+}
+
+// Get returns a given image.
+func (s *Service) Get(_ context.Context, in GetInput) (*GetOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	img, found := s.images[in.Name]
+	if !found {
+		return nil, NewNotFoundError(in.Name)
+	}
+	return &GetOutput{Image: img}, nil
+}
+
+// List returns all images.
+func (s *Service) List(_ context.Context, _ ListInput) (*ListOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := &ListOutput{Images: make([]Image, 0, len(s.images))}
+	for _, img := range s.images {
+		out.Images = append(out.Images, img)
+	}
+	sort.Slice(out.Images, func(i, j int) bool {
+		return out.Images[i].Name < out.Images[j].Name
+	})
+	return out, nil
+}
+
+// Delete removes a given image. Registered host directory is not removed, only its registration.
+// TODO(simplification): Jobs which already run in the image are not tracked, so its files are removed under them.
+func (s *Service) Delete(_ context.Context, in DeleteInput) (*DeleteOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.images[in.Name]; !found {
+		return nil, NewNotFoundError(in.Name)
+	}
+
+	// rename first, so partially removed image is never loaded
+	tmp := filepath.Join(s.dir, tmpDirPrefix+in.Name)
+	if err := os.Rename(filepath.Join(s.dir, in.Name), tmp); err != nil {
+		return nil, errors.Wrap(err, "while deleting image")
+	}
+	delete(s.images, in.Name)
+
+	if err := os.RemoveAll(tmp); err != nil {
+		return nil, errors.Wrap(err, "while removing image files")
+	}
+	return &DeleteOutput{}, nil
+}
+
+// RootfsPath returns path of the root filesystem of a given image.
+func (s *Service) RootfsPath(name string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	img, found := s.images[name]
+	if !found {
+		return "", NewNotFoundError(name)
+	}
+	if img.HostPath != "" {
+		return img.HostPath, nil
+	}
+	return filepath.Join(s.dir, name, rootfsDirName), nil
+}
+
+// reserve returns error if a given name is already taken.
+func (s *Service) reserve(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, exists := s.images[name]
+	_, importing := s.importing[name]
+	if exists || importing {
+		return NewConflictError(name)
+	}
+	s.importing[name] = struct{}{}
+	return nil
+}
+
+func (s *Service) release(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.importing, name)
+}
+
+// commit stores metadata in a given temporary directory and moves it to the final location.
+func (s *Service) commit(img Image, tmp string) error {
+	raw, err := json.Marshal(img)
+	if err != nil {
+		return errors.Wrap(err, "while encoding image metadata")
+	}
+	if err := os.WriteFile(filepath.Join(tmp, metadataFileName), raw, metadataFilePerm); err != nil {
+		return errors.Wrap(err, "while writing image metadata")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.Rename(tmp, filepath.Join(s.dir, img.Name)); err != nil {
+		return errors.Wrap(err, "while storing image")
+	}
+	s.images[img.Name] = img
+	return nil
+}
+
+func loadMetadata(dir string) (Image, error) {
+	raw, err := os.ReadFile(filepath.Clean(filepath.Join(dir, metadataFileName)))
+	if err != nil {
+		return Image{}, errors.Wrap(err, "while reading image metadata")
+	}
+
+	var img Image
+	if err := json.Unmarshal(raw, &img); err != nil {
+		return Image{}, errors.Wrap(err, "while decoding image metadata")
+	}
+	return img, nil
+}
+
+func validateName(name string) error {
+	if !nameRegexp.MatchString(name) {
+		return NewInvalidInputError("invalid image name %q, it must start with a letter or digit, and contain only letters, digits, '.', '_', ':' and '-', up to 128 characters", name)
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package image
+
+// ServiceOption provides an option to configure Service instance.
+type ServiceOption func(cfg *Service)
+
+// WithImportLimits limits the size of imported archives, so a single import cannot exhaust the Agent's disk.
+func WithImportLimits(limits ImportLimits) ServiceOption {
+	return func(cfg *Service) {
+		cfg.importLimits = limits
+	}
+}
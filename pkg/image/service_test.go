@@ -0,0 +1,336 @@
+package image
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceImport(t *testing.T) {
+	// given
+	ctx := context.Background()
+	svc, err := NewService(t.TempDir())
+	require.NoError(t, err)
+
+	archive := gzipped(t, tarball(t, []entry{
+		{hdr: tar.Header{Name: "./", Typeflag: tar.TypeDir, Mode: 0755}},
+		{hdr: tar.Header{Name: "bin/", Typeflag: tar.TypeDir, Mode: 0755}},
+		{hdr: tar.Header{Name: "bin/tool", Typeflag: tar.TypeReg, Mode: 0755}, content: "#!/bin/sh"},
+		{hdr: tar.Header{Name: "bin/tool-link", Typeflag: tar.TypeLink, Linkname: "bin/tool"}},
+		{hdr: tar.Header{Name: "usr/bin", Typeflag: tar.TypeSymlink, Linkname: "/bin"}},
+		{hdr: tar.Header{Name: "../../etc/escaped", Typeflag: tar.TypeReg, Mode: 0600}, content: "inside"},
+		{hdr: tar.Header{Name: "dev/null", Typeflag: tar.TypeChar, Mode: 0666, Devmajor: 1, Devminor: 3}},
+	}))
+
+	// when
+	_, err = svc.Import(ctx, ImportInput{Tenant: "Ricky", Name: "tools:1.0", Archive: archive})
+
+	// then
+	require.NoError(t, err)
+
+	rootfs, err := svc.RootfsPath("tools:1.0")
+	require.NoError(t, err)
+	assertFile(t, filepath.Join(rootfs, "bin/tool"), "#!/bin/sh", 0755)
+	assertFile(t, filepath.Join(rootfs, "bin/tool-link"), "#!/bin/sh", 0755)
+	assertFile(t, filepath.Join(rootfs, "etc/escaped"), "inside", 0600)
+	link, err := os.Readlink(filepath.Join(rootfs, "usr/bin"))
+	require.NoError(t, err)
+	assert.Equal(t, "/bin", link)
+	assert.NoFileExists(t, filepath.Join(rootfs, "dev/null"))
+
+	out, err := svc.List(ctx, ListInput{})
+	require.NoError(t, err)
+	require.Len(t, out.Images, 1)
+	assert.Equal(t, "tools:1.0", out.Images[0].Name)
+	assert.Equal(t, "Ricky", out.Images[0].Tenant)
+	assert.Empty(t, out.Images[0].HostPath)
+}
+
+func TestServiceImportOCILayout(t *testing.T) {
+	// given
+	ctx := context.Background()
+	svc, err := NewService(t.TempDir())
+	require.NoError(t, err)
+
+	base := tarball(t, []entry{
+		{hdr: tar.Header{Name: "etc/", Typeflag: tar.TypeDir, Mode: 0755}},
+		{hdr: tar.Header{Name: "etc/motd", Typeflag: tar.TypeReg, Mode: 0644}, content: "base"},
+		{hdr: tar.Header{Name: "etc/removed", Typeflag: tar.TypeReg, Mode: 0644}, content: "base"},
+		{hdr: tar.Header{Name: "var/cache/", Typeflag: tar.TypeDir, Mode: 0755}},
+		{hdr: tar.Header{Name: "var/cache/old", Typeflag: tar.TypeReg, Mode: 0644}, content: "base"},
+	})
+	top := gzipped(t, tarball(t, []entry{
+		{hdr: tar.Header{Name: "etc/motd", Typeflag: tar.TypeReg, Mode: 0644}, content: "top"},
+		{hdr: tar.Header{Name: "etc/.wh.removed", Typeflag: tar.TypeReg}},
+		{hdr: tar.Header{Name: "var/cache/", Typeflag: tar.TypeDir, Mode: 0755}},
+		{hdr: tar.Header{Name: "var/cache/.wh..wh..opq", Typeflag: tar.TypeReg}},
+		{hdr: tar.Header{Name: "var/cache/new", Typeflag: tar.TypeReg, Mode: 0644}, content: "top"},
+	}))
+	archive := ociLayout(t, base.Bytes(), top.Bytes())
+
+	// when
+	_, err = svc.Import(ctx, ImportInput{Tenant: "Ricky", Name: "layered", Archive: archive})
+
+	// then
+	require.NoError(t, err)
+
+	rootfs, err := svc.RootfsPath("layered")
+	require.NoError(t, err)
+	assertFile(t, filepath.Join(rootfs, "etc/motd"), "top", 0644)
+	assert.NoFileExists(t, filepath.Join(rootfs, "etc/removed"))
+	assert.NoFileExists(t, filepath.Join(rootfs, "var/cache/old"))
+	assertFile(t, filepath.Join(rootfs, "var/cache/new"), "top", 0644)
+	assert.NoDirExists(t, filepath.Join(rootfs, "blobs"))
+}
+
+func TestServiceImportFailures(t *testing.T) {
+	tests := map[string]struct {
+		name    string
+		entries []entry
+		errMsg  string
+	}{
+		"Should reject invalid name": {
+			name:   "../tools",
+			errMsg: `invalid image name "../tools", it must start with a letter or digit, and contain only letters, digits, '.', '_', ':' and '-', up to 128 characters`,
+		},
+		"Should reject already existing image": {
+			name:   "existing",
+			errMsg: `image "existing" already exists`,
+		},
+		"Should reject entry placed under symbolic link": {
+			name: "escaping",
+			entries: []entry{
+				{hdr: tar.Header{Name: "etc", Typeflag: tar.TypeSymlink, Linkname: "/etc"}},
+				{hdr: tar.Header{Name: "etc/passwd", Typeflag: tar.TypeReg, Mode: 0644}, content: "root::0:0::/:/bin/sh"},
+			},
+			errMsg: `while unpacking archive: while unpacking "etc/passwd": path "etc/passwd" goes through symbolic link "/etc"`,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			ctx := context.Background()
+			dir := t.TempDir()
+			svc, err := NewService(dir)
+			require.NoError(t, err)
+			_, err = svc.Import(ctx, ImportInput{Tenant: "Ricky", Name: "existing", Archive: tarball(t, nil)})
+			require.NoError(t, err)
+
+			// when
+			_, err = svc.Import(ctx, ImportInput{Tenant: "Ricky", Name: tc.name, Archive: tarball(t, tc.entries)})
+
+			// then
+			require.EqualError(t, err, tc.errMsg)
+
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			require.Len(t, entries, 1, "leftovers of failed import should be removed")
+			assert.Equal(t, "existing", entries[0].Name())
+		})
+	}
+}
+
+func TestServiceImportLimits(t *testing.T) {
+	entries := []entry{
+		{hdr: tar.Header{Name: "a", Typeflag: tar.TypeReg, Mode: 0644}, content: strings.Repeat("a", 1024)},
+		{hdr: tar.Header{Name: "b", Typeflag: tar.TypeReg, Mode: 0644}, content: strings.Repeat("b", 1024)},
+		{hdr: tar.Header{Name: "c", Typeflag: tar.TypeReg, Mode: 0644}, content: strings.Repeat("c", 1024)},
+	}
+	archiveSize := int64(tarball(t, entries).Len())
+
+	tests := map[string]struct {
+		limits  ImportLimits
+		archive *bytes.Buffer
+		errMsg  string
+	}{
+		"Should import archive within limits": {
+			limits:  ImportLimits{MaxArchiveSize: archiveSize, MaxUnpackedSize: 3 * 1024},
+			archive: tarball(t, entries),
+		},
+		"Should reject archive exceeding max archive size": {
+			limits:  ImportLimits{MaxArchiveSize: archiveSize - 1},
+			archive: tarball(t, entries),
+			errMsg:  fmt.Sprintf(`while unpacking archive: while unpacking "c": archive exceeds the limit of %d bytes`, archiveSize-1),
+		},
+		"Should reject compressed archive exceeding max unpacked size": {
+			limits:  ImportLimits{MaxArchiveSize: 1024, MaxUnpackedSize: 3*1024 - 1},
+			archive: gzipped(t, tarball(t, entries)),
+			errMsg:  `while unpacking archive: while unpacking "c": unpacked image exceeds the limit of 3071 bytes`,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			ctx := context.Background()
+			dir := t.TempDir()
+			svc, err := NewService(dir, WithImportLimits(tc.limits))
+			require.NoError(t, err)
+
+			// when
+			_, err = svc.Import(ctx, ImportInput{Tenant: "Ricky", Name: "limited", Archive: tc.archive})
+
+			// then
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.errMsg)
+			assert.True(t, errors.As(err, new(*InvalidInputError)))
+
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			assert.Empty(t, entries, "leftovers of failed import should be removed")
+		})
+	}
+}
+
+func TestServiceRegister(t *testing.T) {
+	// given
+	ctx := context.Background()
+	svc, err := NewService(t.TempDir())
+	require.NoError(t, err)
+
+	hostPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(hostPath, "file"), []byte("host"), 0600))
+
+	// when
+	_, err = svc.Register(ctx, RegisterInput{Tenant: "admin", Name: "host", HostPath: hostPath})
+	_, fileErr := svc.Register(ctx, RegisterInput{Tenant: "admin", Name: "file", HostPath: filepath.Join(hostPath, "file")})
+	_, relErr := svc.Register(ctx, RegisterInput{Tenant: "admin", Name: "relative", HostPath: "srv/rootfs"})
+
+	// then
+	require.NoError(t, err)
+	assert.EqualError(t, fileErr, `host path "`+filepath.Join(hostPath, "file")+`" is not a directory`)
+	assert.EqualError(t, relErr, `host path "srv/rootfs" must be an absolute path`)
+
+	rootfs, err := svc.RootfsPath("host")
+	require.NoError(t, err)
+	assert.Equal(t, hostPath, rootfs)
+
+	_, err = svc.Delete(ctx, DeleteInput{Name: "host"})
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(hostPath, "file"), "registered directory should be kept")
+}
+
+func TestServiceStateDir(t *testing.T) {
+	// given
+	ctx := context.Background()
+	dir := t.TempDir()
+	svc, err := NewService(dir)
+	require.NoError(t, err)
+
+	_, err = svc.Import(ctx, ImportInput{Tenant: "Ricky", Name: "kept", Archive: tarball(t, nil)})
+	require.NoError(t, err)
+	_, err = svc.Import(ctx, ImportInput{Tenant: "Ricky", Name: "deleted", Archive: tarball(t, nil)})
+	require.NoError(t, err)
+	_, err = svc.Delete(ctx, DeleteInput{Name: "deleted"})
+	require.NoError(t, err)
+	require.NoError(t, os.Mkdir(filepath.Join(dir, tmpDirPrefix+"interrupted"), 0700))
+
+	// when
+	restored, err := NewService(dir)
+
+	// then
+	require.NoError(t, err)
+	out, err := restored.List(ctx, ListInput{})
+	require.NoError(t, err)
+	require.Len(t, out.Images, 1)
+	assert.Equal(t, "kept", out.Images[0].Name)
+	assert.NoDirExists(t, filepath.Join(dir, tmpDirPrefix+"interrupted"))
+
+	_, err = restored.RootfsPath("deleted")
+	assert.EqualError(t, err, `image "deleted" not found`)
+}
+
+type entry struct {
+	hdr     tar.Header
+	content string
+}
+
+func tarball(t *testing.T, entries []entry) *bytes.Buffer {
+	t.Helper()
+
+	buff := &bytes.Buffer{}
+	tw := tar.NewWriter(buff)
+	for _, e := range entries {
+		hdr := e.hdr
+		hdr.Size = int64(len(e.content))
+		require.NoError(t, tw.WriteHeader(&hdr))
+		_, err := tw.Write([]byte(e.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return buff
+}
+
+func gzipped(t *testing.T, in *bytes.Buffer) *bytes.Buffer {
+	t.Helper()
+
+	buff := &bytes.Buffer{}
+	gz := gzip.NewWriter(buff)
+	_, err := gz.Write(in.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	return buff
+}
+
+// ociLayout returns OCI image layout archive with a given layers, the lowest first.
+func ociLayout(t *testing.T, layers ...[]byte) *bytes.Buffer {
+	t.Helper()
+
+	var (
+		entries     []entry
+		descriptors []ociDescriptor
+	)
+	blob := func(content []byte) string {
+		sum := sha256.Sum256(content)
+		digest := hex.EncodeToString(sum[:])
+		entries = append(entries, entry{
+			hdr:     tar.Header{Name: "blobs/sha256/" + digest, Typeflag: tar.TypeReg, Mode: 0644},
+			content: string(content),
+		})
+		return "sha256:" + digest
+	}
+	toJSON := func(in interface{}) []byte {
+		raw, err := json.Marshal(in)
+		require.NoError(t, err)
+		return raw
+	}
+
+	for _, layer := range layers {
+		descriptors = append(descriptors, ociDescriptor{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip", Digest: blob(layer)})
+	}
+	manifest := blob(toJSON(ociManifest{Layers: descriptors}))
+	index := toJSON(ociIndex{Manifests: []ociDescriptor{{MediaType: "application/vnd.oci.image.manifest.v1+json", Digest: manifest}}})
+
+	entries = append(entries,
+		entry{hdr: tar.Header{Name: ociLayoutFileName, Typeflag: tar.TypeReg, Mode: 0644}, content: `{"imageLayoutVersion":"1.0.0"}`},
+		entry{hdr: tar.Header{Name: ociIndexFileName, Typeflag: tar.TypeReg, Mode: 0644}, content: string(index)},
+	)
+	return tarball(t, entries)
+}
+
+func assertFile(t *testing.T, path, content string, perm os.FileMode) {
+	t.Helper()
+
+	got, err := os.ReadFile(filepath.Clean(path))
+	require.NoError(t, err)
+	assert.Equal(t, content, string(got))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, perm, info.Mode().Perm())
+}
@@ -0,0 +1,75 @@
+package image
+
+import (
+	"io"
+	"time"
+)
+
+// Image represents a root filesystem Jobs can run in.
+type Image struct {
+	// Name specifies image name.
+	Name string
+	// Tenant specifies the tenant that imported image.
+	Tenant string
+	// CreatedAt specifies when image was imported.
+	CreatedAt time.Time
+	// HostPath specifies the registered directory on the Agent's host. Empty if image was imported from archive,
+	// so its root filesystem is kept in the Service's directory.
+	HostPath string
+}
+
+// ImportLimits specifies the maximum size of imported archives.
+type ImportLimits struct {
+	// MaxArchiveSize specifies the maximum size in bytes of the archive as it's streamed, so before decompression.
+	// Zero means no limit.
+	MaxArchiveSize int64
+	// MaxUnpackedSize specifies the maximum total size in bytes of files unpacked from the archive, including
+	// OCI image layout blobs unpacked before their layers are applied. Zero means no limit.
+	MaxUnpackedSize int64
+}
+
+type ImportInput struct {
+	// Tenant specifies the tenant that imports image.
+	Tenant string
+	// Name specifies image name.
+	Name string
+	// Archive is a tar archive, optionally compressed with gzip. It contains either a root filesystem,
+	// or an OCI image layout whose layers are unpacked.
+	Archive io.Reader
+}
+
+type ImportOutput struct{}
+
+type RegisterInput struct {
+	// Tenant specifies the tenant that registers image.
+	Tenant string
+	// Name specifies image name.
+	Name string
+	// HostPath specifies an absolute path of the directory on the Agent's host which holds the root filesystem.
+	HostPath string
+}
+
+type RegisterOutput struct{}
+
+type GetInput struct {
+	// Name specifies image name.
+	Name string
+}
+
+type GetOutput struct {
+	Image Image
+}
+
+type ListInput struct{}
+
+type ListOutput struct {
+	// Images holds all images sorted by name.
+	Images []Image
+}
+
+type DeleteInput struct {
+	// Name specifies image name.
+	Name string
+}
+
+type DeleteOutput struct{}
@@ -0,0 +1,177 @@
+package job
+
+import (
+	"sort"
+	"sync"
+)
+
+// QueueOrdering specifies the order in which queued Cmds are started.
+type QueueOrdering string
+
+const (
+	// FIFOOrdering starts queued Cmds in the order they were requested.
+	FIFOOrdering QueueOrdering = "FIFO"
+	// PriorityOrdering starts queued Cmds with higher priority first. Cmds with the same priority are started
+	// in the order they were requested.
+	PriorityOrdering QueueOrdering = "PRIORITY"
+)
+
+// AdmissionLimits specifies how many Cmds can run concurrently. Cmds requested above the limits are queued
+// and started once running Cmds finish.
+type AdmissionLimits struct {
+	// MaxRunning specifies the maximum number of concurrently running Cmds. Zero means no limit.
+	MaxRunning int
+	// MaxRunningPerTenant specifies the maximum number of concurrently running Cmds of a single tenant. Zero means no limit.
+	MaxRunningPerTenant int
+	// Ordering specifies the order in which queued Cmds are started. Defaults to FIFOOrdering.
+	Ordering QueueOrdering
+}
+
+// Validate returns error if limits are not valid.
+func (l AdmissionLimits) Validate() error {
+	if l.MaxRunning < 0 || l.MaxRunningPerTenant < 0 {
+		return NewInvalidInputError("max running Jobs limits cannot be negative")
+	}
+	switch l.Ordering {
+	case "", FIFOOrdering, PriorityOrdering:
+		return nil
+	default:
+		return NewInvalidInputError("unknown queue ordering %q, allowed values: %s, %s", l.Ordering, FIFOOrdering, PriorityOrdering)
+	}
+}
+
+// queuedCmd represents a Cmd waiting in the admission queue.
+type queuedCmd struct {
+	in  RunInput
+	seq uint64
+	// dequeued is closed once the Cmd left the queue, so it was either started, failed to start, or canceled.
+	dequeued chan struct{}
+}
+
+// admission tracks running Cmds and queues the ones requested above the limits.
+// All methods with the Locked suffix must be called under mu.
+type admission struct {
+	limits AdmissionLimits
+
+	mu               sync.Mutex
+	running          int
+	runningPerTenant map[string]int
+	// queue is kept sorted according to the configured ordering.
+	queue   []*queuedCmd
+	nextSeq uint64
+	// starting holds dequeued Cmds until their processes are tracked, indexed by Cmd ID. They are started without
+	// holding mu, but are still found by getLocked, so a given Cmd is always either queued or has its process tracked.
+	starting map[string]*queuedCmd
+}
+
+func newAdmission(limits AdmissionLimits) *admission {
+	return &admission{
+		limits:           limits,
+		runningPerTenant: map[string]int{},
+		starting:         map[string]*queuedCmd{},
+	}
+}
+
+// canStartLocked returns true if a Cmd of a given tenant can be started without exceeding limits.
+func (a *admission) canStartLocked(tenant string) bool {
+	if a.limits.MaxRunning > 0 && a.running >= a.limits.MaxRunning {
+		return false
+	}
+	if a.limits.MaxRunningPerTenant > 0 && a.runningPerTenant[tenant] >= a.limits.MaxRunningPerTenant {
+		return false
+	}
+	return true
+}
+
+func (a *admission) acquireLocked(tenant string) {
+	a.running++
+	a.runningPerTenant[tenant]++
+}
+
+func (a *admission) releaseLocked(tenant string) {
+	a.running--
+	a.runningPerTenant[tenant]--
+	if a.runningPerTenant[tenant] <= 0 {
+		delete(a.runningPerTenant, tenant)
+	}
+}
+
+func (a *admission) enqueueLocked(in RunInput) *queuedCmd {
+	item := &queuedCmd{
+		in:       in,
+		seq:      a.nextSeq,
+		dequeued: make(chan struct{}),
+	}
+	a.nextSeq++
+
+	idx := sort.Search(len(a.queue), func(i int) bool {
+		return a.goesBefore(item, a.queue[i])
+	})
+	a.queue = append(a.queue, nil)
+	copy(a.queue[idx+1:], a.queue[idx:])
+	a.queue[idx] = item
+
+	return item
+}
+
+// goesBefore returns true if a given Cmd should be started before the other one.
+func (a *admission) goesBefore(item, other *queuedCmd) bool {
+	if a.limits.Ordering == PriorityOrdering && item.in.Priority != other.in.Priority {
+		return item.in.Priority > other.in.Priority
+	}
+	return item.seq < other.seq
+}
+
+// dequeueNextLocked removes and returns the first queued Cmd which can be started, and marks it as starting. Cmds
+// of tenants that reached their limit are skipped, so they don't block other tenants. Returns nil if no Cmd can be started.
+func (a *admission) dequeueNextLocked() *queuedCmd {
+	for idx, item := range a.queue {
+		if !a.canStartLocked(item.in.Tenant) {
+			continue
+		}
+		a.queue = append(a.queue[:idx], a.queue[idx+1:]...)
+		a.starting[item.in.id] = item
+		return item
+	}
+	return nil
+}
+
+// startedLocked removes a given Cmd from the starting ones, once it was either started or failed to start.
+func (a *admission) startedLocked(item *queuedCmd) {
+	delete(a.starting, item.in.id)
+	close(item.dequeued)
+}
+
+// removeLocked removes a given Cmd from the queue. Returns false if it's not queued.
+func (a *admission) removeLocked(id string) (*queuedCmd, bool) {
+	for idx, item := range a.queue {
+		if item.in.id == id {
+			a.queue = append(a.queue[:idx], a.queue[idx+1:]...)
+			return item, true
+		}
+	}
+	return nil, false
+}
+
+// getLocked returns a given Cmd if it's queued or being started.
+func (a *admission) getLocked(id string) (*queuedCmd, bool) {
+	for _, item := range a.queue {
+		if item.in.id == id {
+			return item, true
+		}
+	}
+	item, found := a.starting[id]
+	return item, found
+}
+
+// positions returns queue positions, starting from 1, indexed by Cmd ID.
+func (a *admission) positions() map[string]int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	out := make(map[string]int, len(a.queue))
+	for idx, item := range a.queue {
+		out[item.in.id] = idx + 1
+	}
+	return out
+}
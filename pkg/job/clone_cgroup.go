@@ -0,0 +1,27 @@
+//go:build go1.20
+// +build go1.20
+
+package job
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// startIntoCgroup starts a given command directly in a given cgroup using CLONE_INTO_CGROUP,
+// so the process never runs outside the cgroup.
+func startIntoCgroup(cmd *exec.Cmd, cgroupPath string) error {
+	dir, err := os.Open(cgroupPath)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = int(dir.Fd())
+	return cmd.Start()
+}
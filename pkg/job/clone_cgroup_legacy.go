@@ -0,0 +1,11 @@
+//go:build !go1.20
+// +build !go1.20
+
+package job
+
+import "os/exec"
+
+// startIntoCgroup is not supported, as CLONE_INTO_CGROUP is available in os/exec since Go 1.20.
+func startIntoCgroup(_ *exec.Cmd, _ string) error {
+	return errCloneIntoCgroupNotSupported
+}
@@ -1,9 +1,122 @@
 package job
 
 import (
+	"fmt"
+
 	"github.com/cockroachdb/errors/errbase"
 )
 
+// InvalidInputError is returned if a given input doesn't pass validation.
+type InvalidInputError struct {
+	reason string
+}
+
+// NewInvalidInputError returns a new InvalidInputError instance.
+func NewInvalidInputError(format string, args ...interface{}) *InvalidInputError {
+	return &InvalidInputError{reason: fmt.Sprintf(format, args...)}
+}
+
+// Error returns error message.
+func (e InvalidInputError) Error() string {
+	return e.reason
+}
+
+// InvalidInput implements behavior error interface.
+func (e InvalidInputError) InvalidInput() {}
+
+// PermissionDeniedError is returned if the tenant is not allowed to perform a given operation.
+type PermissionDeniedError struct {
+	reason string
+}
+
+// NewPermissionDeniedError returns a new PermissionDeniedError instance.
+func NewPermissionDeniedError(format string, args ...interface{}) *PermissionDeniedError {
+	return &PermissionDeniedError{reason: fmt.Sprintf(format, args...)}
+}
+
+// Error returns error message.
+func (e PermissionDeniedError) Error() string {
+	return e.reason
+}
+
+// PermissionDenied implements behavior error interface.
+func (e PermissionDeniedError) PermissionDenied() {}
+
+// StatsNotAvailableError is returned if resources usage stats cannot be read for a given Job.
+type StatsNotAvailableError struct {
+	jobName string
+	reason  string
+}
+
+// NewStatsNotAvailableError returns a new StatsNotAvailableError instance.
+func NewStatsNotAvailableError(jobName, reason string) *StatsNotAvailableError {
+	return &StatsNotAvailableError{jobName: jobName, reason: reason}
+}
+
+// Error returns error message.
+func (e StatsNotAvailableError) Error() string {
+	return fmt.Sprintf("stats for Job %q are not available: %s", e.jobName, e.reason)
+}
+
+// FailedPrecondition implements behavior error interface.
+func (e StatsNotAvailableError) FailedPrecondition() {}
+
+// NotRunningError is returned if a given operation requires a running Job.
+type NotRunningError struct {
+	jobName string
+	status  Status
+}
+
+// NewNotRunningError returns a new NotRunningError instance.
+func NewNotRunningError(jobName string, status Status) *NotRunningError {
+	return &NotRunningError{jobName: jobName, status: status}
+}
+
+// Error returns error message.
+func (e NotRunningError) Error() string {
+	return fmt.Sprintf("Job %q is not running, current status: %s", e.jobName, e.status)
+}
+
+// FailedPrecondition implements behavior error interface.
+func (e NotRunningError) FailedPrecondition() {}
+
+// NotFinishedError is returned if a given operation requires a finished Job.
+type NotFinishedError struct {
+	jobName string
+	status  Status
+}
+
+// NewNotFinishedError returns a new NotFinishedError instance.
+func NewNotFinishedError(jobName string, status Status) *NotFinishedError {
+	return &NotFinishedError{jobName: jobName, status: status}
+}
+
+// Error returns error message.
+func (e NotFinishedError) Error() string {
+	return fmt.Sprintf("Job %q is not finished, current status: %s", e.jobName, e.status)
+}
+
+// FailedPrecondition implements behavior error interface.
+func (e NotFinishedError) FailedPrecondition() {}
+
+// LostProcessesRunningError is returned if a given lost Job cannot be deleted, as its processes are still running.
+type LostProcessesRunningError struct {
+	jobName string
+}
+
+// NewLostProcessesRunningError returns a new LostProcessesRunningError instance.
+func NewLostProcessesRunningError(jobName string) *LostProcessesRunningError {
+	return &LostProcessesRunningError{jobName: jobName}
+}
+
+// Error returns error message.
+func (e LostProcessesRunningError) Error() string {
+	return fmt.Sprintf("Job %q was lost, but its processes are still running, force the deletion to kill them", e.jobName)
+}
+
+// FailedPrecondition implements behavior error interface.
+func (e LostProcessesRunningError) FailedPrecondition() {}
+
 // TODO: it will be good to establish error handling between layers (DSO, domain, DTO), so we can easily
 // map repository (in-memory, redis, etc.) NotFound error to domain NotFound error (library) and later to any DTO NotFound error (gRPC, REST, etc.)
 
@@ -29,6 +142,39 @@ func IsConflictError(err error) bool {
 	})
 }
 
+// IsInvalidInputError checks if any underlying error implements InvalidInput error interface a.k.a behaviour InvalidInput error.
+func IsInvalidInputError(err error) bool {
+	type invalidInput interface {
+		InvalidInput()
+	}
+	return AppliesToAny(err, func(err error) bool {
+		_, ok := err.(invalidInput)
+		return ok
+	})
+}
+
+// IsFailedPreconditionError checks if any underlying error implements FailedPrecondition error interface a.k.a behaviour FailedPrecondition error.
+func IsFailedPreconditionError(err error) bool {
+	type failedPrecondition interface {
+		FailedPrecondition()
+	}
+	return AppliesToAny(err, func(err error) bool {
+		_, ok := err.(failedPrecondition)
+		return ok
+	})
+}
+
+// IsPermissionDeniedError checks if any underlying error implements PermissionDenied error interface a.k.a behaviour PermissionDenied error.
+func IsPermissionDeniedError(err error) bool {
+	type permissionDenied interface {
+		PermissionDenied()
+	}
+	return AppliesToAny(err, func(err error) bool {
+		_, ok := err.(permissionDenied)
+		return ok
+	})
+}
+
 // AppliesToAny checks if given condition applies to any error in the 'cause' chain.
 // It supports both errors implementing:
 // - causer, via `Cause()` method, from community libraries,
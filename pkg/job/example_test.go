@@ -40,7 +40,7 @@ func Example() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	_, err = svc.Run(ctx, job.RunInput{
+	runOut, err := svc.Run(ctx, job.RunInput{
 		Tenant:  tenant,
 		Name:    jobName,
 		Command: "sh",
@@ -49,20 +49,20 @@ func Example() {
 	})
 	fatalOnErr(err)
 
-	getOut, err := svc.Get(ctx, job.GetInput{Name: jobName})
+	getOut, err := svc.Get(ctx, job.GetInput{ID: runOut.ID})
 	fatalOnErr(err)
 
 	fmt.Printf("'Get' of just started Job: %s\n\n", getOut)
 	time.Sleep(time.Second)
 
-	stream, err := svc.StreamLogs(ctx, job.StreamLogsInput{Name: jobName})
+	stream, err := svc.StreamLogs(ctx, job.StreamLogsInput{ID: runOut.ID, Follow: true})
 	fatalOnErr(err)
 
 	fmt.Println("Stream logs:")
-	err = job.ForwardStreamLogs(ctx, os.Stdout, stream)
+	err = job.ForwardStreamLogs(ctx, os.Stdout, os.Stderr, stream)
 	fatalOnErr(err)
 
-	getOut, err = svc.Get(ctx, job.GetInput{Name: jobName})
+	getOut, err = svc.Get(ctx, job.GetInput{ID: runOut.ID})
 	fatalOnErr(err)
 
 	fmt.Println()
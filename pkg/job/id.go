@@ -0,0 +1,72 @@
+package job
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// idBytes specifies the number of random bytes in the Cmd ID.
+	idBytes = 10
+	// generatedNameSuffixLen specifies the length of the random suffix appended to GenerateName.
+	generatedNameSuffixLen = 5
+	// generatedNameSuffixChars holds characters of the random suffix. Vowels are skipped, so no words are generated.
+	generatedNameSuffixChars = "bcdfghjklmnpqrstvwxz2456789"
+	// maxGenerateNameAttempts limits how many names are generated before giving up on conflicts.
+	maxGenerateNameAttempts = 8
+	// namespaceSeparator separates tenant and Cmd name in namespaced names, e.g. "Ricky/build".
+	namespaceSeparator = "/"
+)
+
+// idRegexp matches generated IDs. Names cannot match it, so references are never ambiguous.
+var idRegexp = regexp.MustCompile(`^[0-9a-f]{20}$`)
+
+// newID returns a new random Cmd ID.
+func newID() (string, error) {
+	raw := make([]byte, idBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", errors.Wrap(err, "while generating ID")
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+// generateName returns a given prefix with a random suffix appended.
+func generateName(prefix string) (string, error) {
+	suffix := make([]byte, generatedNameSuffixLen)
+	for idx := range suffix {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(generatedNameSuffixChars))))
+		if err != nil {
+			return "", errors.Wrap(err, "while generating name")
+		}
+		suffix[idx] = generatedNameSuffixChars[n.Int64()]
+	}
+	return prefix + string(suffix), nil
+}
+
+// validateName returns error if a given name cannot be used as Cmd name.
+func validateName(name string) error {
+	switch {
+	case name == "":
+		return NewInvalidInputError("name cannot be empty")
+	case strings.Contains(name, namespaceSeparator):
+		return NewInvalidInputError("name %q cannot contain %q", name, namespaceSeparator)
+	case idRegexp.MatchString(name):
+		return NewInvalidInputError("name %q cannot have the format of Job ID", name)
+	}
+	return nil
+}
+
+// SplitNamespacedName splits a given reference into tenant and name. It returns false if the reference is not
+// a namespaced name, e.g. "Ricky/build", so it's either Job ID or name within the caller's namespace.
+func SplitNamespacedName(ref string) (string, string, bool) {
+	idx := strings.Index(ref, namespaceSeparator)
+	if idx < 0 {
+		return "", "", false
+	}
+	return ref[:idx], ref[idx+len(namespaceSeparator):], true
+}
@@ -0,0 +1,249 @@
+package job
+
+import (
+	"os"
+	"os/user"
+	"strconv"
+	"syscall"
+
+	"github.com/cockroachdb/errors"
+)
+
+// AnyTenant is the IdentityPolicy key of the rule used for tenants without a dedicated rule.
+const AnyTenant = "*"
+
+// IdentityPolicy specifies which users and groups Cmds of a given tenant can run as.
+type IdentityPolicy struct {
+	// Tenants holds rules indexed by tenant name. The rule under AnyTenant applies to tenants without a dedicated rule.
+	// Tenants without any rule cannot run Cmds at all.
+	Tenants map[string]IdentityRule `json:"tenants"`
+}
+
+// IdentityRule specifies users and groups allowed for a single tenant.
+type IdentityRule struct {
+	// DefaultUser specifies the user, name or UID, used if Cmd doesn't request any. If empty, the Agent's user is used.
+	DefaultUser string `json:"defaultUser"`
+	// AllowedUIDs holds UIDs the tenant's Cmds can run as. It also applies to the default user.
+	AllowedUIDs []uint32 `json:"allowedUIDs"`
+	// AllowedGIDs holds GIDs the tenant's Cmds can run as, both as primary and supplementary groups.
+	AllowedGIDs []uint32 `json:"allowedGIDs"`
+}
+
+func (p *IdentityPolicy) ruleFor(tenant string) (IdentityRule, bool) {
+	if rule, found := p.Tenants[tenant]; found {
+		return rule, true
+	}
+	rule, found := p.Tenants[AnyTenant]
+	return rule, found
+}
+
+// check returns error if a given credential is not allowed by the rule.
+func (r IdentityRule) check(tenant string, cred *syscall.Credential) error {
+	if !containsID(r.AllowedUIDs, cred.Uid) {
+		return NewPermissionDeniedError("tenant %q is not allowed to run Jobs as UID %d", tenant, cred.Uid)
+	}
+	for _, gid := range append([]uint32{cred.Gid}, cred.Groups...) {
+		if !containsID(r.AllowedGIDs, gid) {
+			return NewPermissionDeniedError("tenant %q is not allowed to run Jobs with GID %d", tenant, gid)
+		}
+	}
+	return nil
+}
+
+// resolveCredential resolves the user and groups requested for a given Cmd, and checks them against the policy.
+// Returns nil if the Cmd inherits the Agent's credential. If policy is nil, all users and groups are allowed.
+// The Agent's host user and group databases don't apply to the Cmd running in a rootfs image, so it accepts only IDs.
+func resolveCredential(in RunInput, policy *IdentityPolicy) (*syscall.Credential, error) {
+	userName := in.User
+	var rule IdentityRule
+	if policy != nil {
+		var found bool
+		rule, found = policy.ruleFor(in.Tenant)
+		if !found {
+			return nil, NewPermissionDeniedError("tenant %q is not allowed to run Jobs", in.Tenant)
+		}
+		if userName == "" {
+			userName = rule.DefaultUser
+		}
+	}
+
+	inherit := userName == "" && in.Group == "" && len(in.SupplementaryGroups) == 0
+	if inherit && policy == nil {
+		return nil, nil
+	}
+
+	lookup := lookupCredential
+	if in.rootfsPath != "" {
+		lookup = parseCredential
+	}
+	cred, err := lookup(userName, in.Group, in.SupplementaryGroups)
+	if err != nil {
+		return nil, err
+	}
+	if policy != nil {
+		if err := rule.check(in.Tenant, cred); err != nil {
+			return nil, err
+		}
+	}
+
+	if inherit { // the Agent's credential is allowed, keep also its supplementary groups
+		return nil, nil
+	}
+	return cred, nil
+}
+
+// lookupCredential resolves users and groups specified either by names or IDs. If user is empty, the Agent's user
+// is used. If group is empty, the user's primary group is used.
+func lookupCredential(userName, group string, supplementaryGroups []string) (*syscall.Credential, error) {
+	cred := &syscall.Credential{Uid: uint32(os.Getuid()), Gid: uint32(os.Getgid()), Groups: []uint32{}}
+
+	if userName != "" {
+		uid, gid, err := lookupUser(userName)
+		if err != nil {
+			return nil, err
+		}
+		if gid == nil && group == "" {
+			return nil, NewInvalidInputError("user %q has no passwd entry, so group must be specified", userName)
+		}
+		cred.Uid = uid
+		if gid != nil {
+			cred.Gid = *gid
+		}
+	}
+
+	if group != "" {
+		gid, err := lookupGroup(group)
+		if err != nil {
+			return nil, err
+		}
+		cred.Gid = gid
+	}
+
+	for _, name := range supplementaryGroups {
+		gid, err := lookupGroup(name)
+		if err != nil {
+			return nil, err
+		}
+		cred.Groups = append(cred.Groups, gid)
+	}
+
+	return cred, nil
+}
+
+// parseCredential parses users and groups specified by IDs. If user is empty, the Agent's user is used.
+// Group must be specified together with the user, as the user's primary group is not known.
+func parseCredential(userName, group string, supplementaryGroups []string) (*syscall.Credential, error) {
+	cred := &syscall.Credential{Uid: uint32(os.Getuid()), Gid: uint32(os.Getgid()), Groups: []uint32{}}
+
+	if userName != "" {
+		uid, err := parseID(userName)
+		if err != nil {
+			return nil, NewInvalidInputError("user %q must be specified by UID, as names are not resolved in rootfs image", userName)
+		}
+		if group == "" {
+			return nil, NewInvalidInputError("group must be specified together with user %q, as primary groups are not resolved in rootfs image", userName)
+		}
+		cred.Uid = uid
+	}
+
+	if group != "" {
+		gid, err := parseGroupID(group)
+		if err != nil {
+			return nil, err
+		}
+		cred.Gid = gid
+	}
+
+	for _, name := range supplementaryGroups {
+		gid, err := parseGroupID(name)
+		if err != nil {
+			return nil, err
+		}
+		cred.Groups = append(cred.Groups, gid)
+	}
+
+	return cred, nil
+}
+
+func parseGroupID(name string) (uint32, error) {
+	gid, err := parseID(name)
+	if err != nil {
+		return 0, NewInvalidInputError("group %q must be specified by GID, as names are not resolved in rootfs image", name)
+	}
+	return gid, nil
+}
+
+// lookupUser returns UID and primary GID of a given user. Numeric UID doesn't need to have a passwd entry,
+// in such case the returned GID is nil.
+func lookupUser(name string) (uint32, *uint32, error) {
+	if uid, err := parseID(name); err == nil {
+		usr, err := user.LookupId(name)
+		switch {
+		case errors.As(err, new(user.UnknownUserIdError)):
+			return uid, nil, nil
+		case err != nil:
+			return 0, nil, errors.Wrapf(err, "while looking up user %q", name)
+		}
+		gid, err := parseID(usr.Gid)
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "while parsing primary group of user %q", name)
+		}
+		return uid, &gid, nil
+	}
+
+	usr, err := user.Lookup(name)
+	switch {
+	case errors.As(err, new(user.UnknownUserError)):
+		return 0, nil, NewInvalidInputError("unknown user %q", name)
+	case err != nil:
+		return 0, nil, errors.Wrapf(err, "while looking up user %q", name)
+	}
+	uid, err := parseID(usr.Uid)
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "while parsing UID of user %q", name)
+	}
+	gid, err := parseID(usr.Gid)
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "while parsing primary group of user %q", name)
+	}
+	return uid, &gid, nil
+}
+
+// lookupGroup returns GID of a given group. Numeric GID doesn't need to have a group entry.
+func lookupGroup(name string) (uint32, error) {
+	if gid, err := parseID(name); err == nil {
+		return gid, nil
+	}
+
+	grp, err := user.LookupGroup(name)
+	switch {
+	case errors.As(err, new(user.UnknownGroupError)):
+		return 0, NewInvalidInputError("unknown group %q", name)
+	case err != nil:
+		return 0, errors.Wrapf(err, "while looking up group %q", name)
+	}
+	return parseID(grp.Gid)
+}
+
+func parseID(in string) (uint32, error) {
+	id, err := strconv.ParseUint(in, 10, 32)
+	return uint32(id), err
+}
+
+func containsID(ids []uint32, id uint32) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+// parseUmask parses umask specified in octal notation, e.g. "0027".
+func parseUmask(in string) (int, error) {
+	umask, err := strconv.ParseUint(in, 8, 32)
+	if err != nil || umask > 0777 {
+		return 0, NewInvalidInputError("invalid umask %q, it must be an octal number between 0000 and 0777", in)
+	}
+	return int(umask), nil
+}
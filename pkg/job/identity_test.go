@@ -0,0 +1,189 @@
+package job
+
+import (
+	"os"
+	"strconv"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveCredential(t *testing.T) {
+	agentUID, agentGID := uint32(os.Getuid()), uint32(os.Getgid())
+	policy := &IdentityPolicy{
+		Tenants: map[string]IdentityRule{
+			"Ricky": {
+				DefaultUser: "1000",
+				AllowedUIDs: []uint32{1000, 1001},
+				AllowedGIDs: []uint32{1000, 1001},
+			},
+			"admin": {
+				AllowedUIDs: []uint32{agentUID},
+				AllowedGIDs: []uint32{agentGID},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		in      RunInput
+		policy  *IdentityPolicy
+		expCred *syscall.Credential
+	}{
+		"Should inherit Agent's credential without policy": {
+			in: RunInput{Tenant: "Ricky"},
+		},
+		"Should use requested IDs without policy": {
+			in:      RunInput{Tenant: "Ricky", User: "0", Group: "10", SupplementaryGroups: []string{"20", "30"}},
+			expCred: &syscall.Credential{Uid: 0, Gid: 10, Groups: []uint32{20, 30}},
+		},
+		"Should use default user from policy": {
+			in:      RunInput{Tenant: "Ricky", Group: "1000"},
+			policy:  policy,
+			expCred: &syscall.Credential{Uid: 1000, Gid: 1000, Groups: []uint32{}},
+		},
+		"Should use requested user allowed by policy": {
+			in:      RunInput{Tenant: "Ricky", User: "1001", Group: "1001", SupplementaryGroups: []string{"1000"}},
+			policy:  policy,
+			expCred: &syscall.Credential{Uid: 1001, Gid: 1001, Groups: []uint32{1000}},
+		},
+		"Should inherit Agent's credential if policy allows it": {
+			in:     RunInput{Tenant: "admin"},
+			policy: policy,
+		},
+		"Should use requested IDs in rootfs image": {
+			in:      RunInput{Tenant: "Ricky", User: "1001", Group: "1001", SupplementaryGroups: []string{"1000"}, rootfsPath: "/images/tools/rootfs"},
+			policy:  policy,
+			expCred: &syscall.Credential{Uid: 1001, Gid: 1001, Groups: []uint32{1000}},
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			cred, err := resolveCredential(tc.in, tc.policy)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expCred, cred)
+		})
+	}
+}
+
+func TestResolveCredentialFailures(t *testing.T) {
+	policy := &IdentityPolicy{
+		Tenants: map[string]IdentityRule{
+			"Ricky": {
+				AllowedUIDs: []uint32{1000},
+				AllowedGIDs: []uint32{1000},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		in               RunInput
+		policy           *IdentityPolicy
+		errMsg           string
+		permissionDenied bool
+	}{
+		"Should reject tenant without rule": {
+			in:               RunInput{Tenant: "Morty", User: "1000", Group: "1000"},
+			policy:           policy,
+			errMsg:           `tenant "Morty" is not allowed to run Jobs`,
+			permissionDenied: true,
+		},
+		"Should reject root if not allowed": {
+			in:               RunInput{Tenant: "Ricky", User: "0", Group: "1000"},
+			policy:           policy,
+			errMsg:           `tenant "Ricky" is not allowed to run Jobs as UID 0`,
+			permissionDenied: true,
+		},
+		"Should reject Agent's user if not allowed": {
+			in:               RunInput{Tenant: "Ricky"},
+			policy:           policy,
+			errMsg:           `tenant "Ricky" is not allowed to run Jobs as UID ` + strconv.Itoa(os.Getuid()),
+			permissionDenied: true,
+		},
+		"Should reject supplementary group not allowed": {
+			in:               RunInput{Tenant: "Ricky", User: "1000", Group: "1000", SupplementaryGroups: []string{"0"}},
+			policy:           policy,
+			errMsg:           `tenant "Ricky" is not allowed to run Jobs with GID 0`,
+			permissionDenied: true,
+		},
+		"Should reject unknown user": {
+			in:     RunInput{Tenant: "Ricky", User: "no-such-user-42"},
+			errMsg: `unknown user "no-such-user-42"`,
+		},
+		"Should reject unknown group": {
+			in:     RunInput{Tenant: "Ricky", Group: "no-such-group-42"},
+			errMsg: `unknown group "no-such-group-42"`,
+		},
+		"Should require group for UID without passwd entry": {
+			in:     RunInput{Tenant: "Ricky", User: "4242424"},
+			errMsg: `user "4242424" has no passwd entry, so group must be specified`,
+		},
+		"Should reject user name in rootfs image": {
+			in:     RunInput{Tenant: "Ricky", User: "root", Group: "0", rootfsPath: "/images/tools/rootfs"},
+			errMsg: `user "root" must be specified by UID, as names are not resolved in rootfs image`,
+		},
+		"Should reject group name in rootfs image": {
+			in:     RunInput{Tenant: "Ricky", User: "0", Group: "0", SupplementaryGroups: []string{"root"}, rootfsPath: "/images/tools/rootfs"},
+			errMsg: `group "root" must be specified by GID, as names are not resolved in rootfs image`,
+		},
+		"Should require group for user in rootfs image": {
+			in:     RunInput{Tenant: "Ricky", User: "0", rootfsPath: "/images/tools/rootfs"},
+			errMsg: `group must be specified together with user "0", as primary groups are not resolved in rootfs image`,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			_, err := resolveCredential(tc.in, tc.policy)
+
+			// then
+			require.Error(t, err)
+			assert.EqualError(t, err, tc.errMsg)
+			assert.Equal(t, tc.permissionDenied, IsPermissionDeniedError(err))
+			assert.Equal(t, !tc.permissionDenied, IsInvalidInputError(err))
+		})
+	}
+}
+
+func TestParseUmask(t *testing.T) {
+	tests := map[string]struct {
+		in       string
+		expected int
+		errMsg   string
+	}{
+		"Should parse umask with leading zero": {
+			in:       "0027",
+			expected: 027,
+		},
+		"Should parse umask without leading zero": {
+			in:       "777",
+			expected: 0777,
+		},
+		"Should reject non-octal umask": {
+			in:     "0089",
+			errMsg: `invalid umask "0089", it must be an octal number between 0000 and 0777`,
+		},
+		"Should reject too big umask": {
+			in:     "01000",
+			errMsg: `invalid umask "01000", it must be an octal number between 0000 and 0777`,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			got, err := parseUmask(tc.in)
+
+			// then
+			if tc.errMsg != "" {
+				assert.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
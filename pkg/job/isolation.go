@@ -0,0 +1,126 @@
+package job
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// Isolation specifies Linux namespaces in which Cmd runs.
+type Isolation string
+
+// initStatusFD is the file descriptor of the child wrapper running as init, to which it reports the command's wait status.
+const initStatusFD = 3
+
+const (
+	// IsolationHost indicates that Cmd runs in the host namespaces.
+	IsolationHost Isolation = "HOST"
+	// IsolationNamespaces indicates that Cmd runs in new PID, mount, UTS and IPC namespaces with a private /proc.
+	IsolationNamespaces Isolation = "NAMESPACES"
+	// IsolationNamespacesWithNetwork indicates that Cmd additionally runs in a new network namespace
+	// with only loopback interface.
+	IsolationNamespacesWithNetwork Isolation = "NAMESPACES_WITH_NETWORK"
+)
+
+// Validate returns error if isolation is not known. Empty isolation is valid and means the Service's default.
+func (i Isolation) Validate() error {
+	switch i {
+	case "", IsolationHost, IsolationNamespaces, IsolationNamespacesWithNetwork:
+		return nil
+	default:
+		return NewInvalidInputError("unknown isolation %q", i)
+	}
+}
+
+// Namespaced returns true if Cmd runs in new namespaces, so the child wrapper needs to set them up.
+// In such case, the child wrapper is the init of new PID namespace, and the command runs as its descendant.
+func (i Isolation) Namespaced() bool {
+	return i.cloneflags() != 0
+}
+
+// Network returns true if Cmd runs in a new network namespace.
+func (i Isolation) Network() bool {
+	return i == IsolationNamespacesWithNetwork
+}
+
+// cloneflags returns flags for creating new namespaces for Cmd's process.
+func (i Isolation) cloneflags() uintptr {
+	const namespaces = syscall.CLONE_NEWPID | syscall.CLONE_NEWNS | syscall.CLONE_NEWUTS | syscall.CLONE_NEWIPC
+
+	switch i {
+	case IsolationNamespaces:
+		return namespaces
+	case IsolationNamespacesWithNetwork:
+		return namespaces | syscall.CLONE_NEWNET
+	default:
+		return 0
+	}
+}
+
+// jobCmd is the started process of a single Job's attempt.
+type jobCmd struct {
+	*exec.Cmd
+	// initStatus is the read end of the pipe to which the init of new namespaces reports the wait status of the command.
+	// The init exit code cannot tell whether the command was killed by a signal, or exited on its own with 128+signal
+	// number. Nil if the Job runs in the host namespaces, so the process is the command itself.
+	initStatus *os.File
+	// reportedStatus is read from initStatus once the process exited. Nil if the init didn't report it, e.g. it was killed.
+	reportedStatus *syscall.WaitStatus
+}
+
+// start starts the process. Files passed to it are closed, as the process holds their copies.
+func (c *jobCmd) start() error {
+	err := c.Cmd.Start()
+	for _, f := range c.ExtraFiles {
+		_ = f.Close()
+	}
+	if err != nil && c.initStatus != nil {
+		_ = c.initStatus.Close()
+	}
+	return err
+}
+
+// wait waits for the process to exit, and reads the command's wait status reported by the init, if any.
+func (c *jobCmd) wait() error {
+	err := c.Cmd.Wait()
+	if c.initStatus != nil {
+		c.reportedStatus = readInitStatus(c.initStatus)
+		_ = c.initStatus.Close()
+	}
+	return err
+}
+
+// waitStatus returns the wait status of the Job's command. It can be called only once wait returned.
+func (c *jobCmd) waitStatus() (syscall.WaitStatus, bool) {
+	if c.reportedStatus != nil {
+		return *c.reportedStatus, true
+	}
+	status, ok := c.ProcessState.Sys().(syscall.WaitStatus)
+	return status, ok
+}
+
+// readInitStatus reads the wait status reported by the init. Returns nil if nothing was reported.
+func readInitStatus(r io.Reader) *syscall.WaitStatus {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil
+	}
+	status, err := strconv.ParseUint(strings.TrimSpace(string(raw)), 10, 32)
+	if err != nil {
+		return nil
+	}
+	out := syscall.WaitStatus(status)
+	return &out
+}
+
+// terminatingSignal returns the signal that killed the Job's command.
+func terminatingSignal(cmd *jobCmd) (syscall.Signal, bool) {
+	status, ok := cmd.waitStatus()
+	if !ok || !status.Signaled() {
+		return 0, false
+	}
+	return status.Signal(), true
+}
@@ -0,0 +1,73 @@
+package job
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerminatingSignal(t *testing.T) {
+	tests := map[string]struct {
+		script string
+		// initStatus is reported as by the init of new namespaces. Nil means the process runs in the host namespaces.
+		initStatus *string
+		expSignal  syscall.Signal
+		expKilled  bool
+	}{
+		"Should return signal that killed process": {
+			script:    "kill -TERM $$",
+			expSignal: syscall.SIGTERM,
+			expKilled: true,
+		},
+		"Should return signal reported by init of new namespaces": {
+			script:     "exit 143",
+			initStatus: strPtr("15"), // killed by SIGTERM
+			expSignal:  syscall.SIGTERM,
+			expKilled:  true,
+		},
+		"Should not decode 128+signal number exit code reported by init of new namespaces": {
+			script:     "exit 137",
+			initStatus: strPtr("35072"), // exited with 137
+		},
+		"Should not decode 128+signal number exit code of process in host namespaces": {
+			script: "exit 143",
+		},
+		"Should return signal that killed init which didn't report status": {
+			script:     "kill -KILL $$",
+			initStatus: strPtr(""),
+			expSignal:  syscall.SIGKILL,
+			expKilled:  true,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			cmd := &jobCmd{Cmd: exec.Command("sh", "-c", tc.script)}
+			if tc.initStatus != nil {
+				statusReader, statusWriter, err := os.Pipe()
+				require.NoError(t, err)
+				_, err = statusWriter.WriteString(*tc.initStatus)
+				require.NoError(t, err)
+				cmd.initStatus, cmd.ExtraFiles = statusReader, []*os.File{statusWriter}
+			}
+			require.NoError(t, cmd.start())
+			_ = cmd.wait()
+			require.NotNil(t, cmd.ProcessState)
+
+			// when
+			sig, killed := terminatingSignal(cmd)
+
+			// then
+			assert.Equal(t, tc.expKilled, killed)
+			assert.Equal(t, tc.expSignal, sig)
+		})
+	}
+}
+
+func strPtr(in string) *string {
+	return &in
+}
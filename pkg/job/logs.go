@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"io"
+
+	"github.com/mszostok/job-runner/pkg/file"
 )
 
-func ForwardStreamLogs(ctx context.Context, w io.Writer, stream *StreamLogsOutput) error {
+// ForwardStreamLogs writes streamed logs to the writer matching the stream that produced a given chunk.
+func ForwardStreamLogs(ctx context.Context, stdout, stderr io.Writer, stream *StreamLogsOutput) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -15,7 +18,11 @@ func ForwardStreamLogs(ctx context.Context, w io.Writer, stream *StreamLogsOutpu
 			if !ok { // out closed, no need to watch for new messages
 				return nil
 			}
-			fmt.Fprintf(w, "%s", msg) // assumption that it UTF-8
+			w := stdout
+			if msg.Stream == file.Stderr {
+				w = stderr
+			}
+			fmt.Fprintf(w, "%s", msg.Data) // assumption that it UTF-8
 		case err := <-stream.Error:
 			if err == io.EOF {
 				return nil
@@ -1,11 +1,14 @@
 package job
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/mszostok/job-runner/pkg/cgroup"
 )
 
 // DefaultProcResources describe default resources' limits set by Agent.
-// TODO(simplification): make me configurable
+// They are used for controllers which are not specified in RunInput.Resources.
 var DefaultProcResources = cgroup.Resources{
 	IO: &cgroup.IO{
 		// Max represent max IO ops. If a given type is specified more than once, the last one takes precedence.
@@ -39,3 +42,75 @@ var DefaultProcResources = cgroup.Resources{
 		Max: 104857600, // 100MB (1024^2*100)
 	},
 }
+
+// ResourcesLimits describes the maximum resources' limits that can be requested for a single Job.
+// Zero value means that a given resource is not restricted.
+type ResourcesLimits struct {
+	// MaxCPUs specifies the maximum CPU bandwidth expressed as a number of CPUs, e.g. 2.5.
+	MaxCPUs float64
+	// MaxMemory specifies the maximum memory.max value in bytes.
+	MaxMemory int64
+	// MaxIORate specifies the maximum rate for each io.max entry.
+	MaxIORate uint64
+}
+
+// Validate returns an error if given resources exceed configured limits.
+func (l ResourcesLimits) Validate(in cgroup.Resources) error {
+	var issues []string
+
+	if in.CPU != nil && in.CPU.Max != "" {
+		cpus, limited, err := in.CPU.MaxCPUs()
+		switch {
+		case err != nil:
+			issues = append(issues, err.Error())
+		case l.MaxCPUs > 0 && !limited:
+			issues = append(issues, fmt.Sprintf("unlimited CPU bandwidth is not allowed, max is %g CPUs", l.MaxCPUs))
+		case l.MaxCPUs > 0 && cpus > l.MaxCPUs:
+			issues = append(issues, fmt.Sprintf("requested %g CPUs exceeds the limit of %g CPUs", cpus, l.MaxCPUs))
+		}
+	}
+
+	if in.Memory != nil {
+		if in.Memory.Min < 0 || in.Memory.Max < 0 {
+			issues = append(issues, "memory limits cannot be negative")
+		}
+		if l.MaxMemory > 0 && in.Memory.Max > l.MaxMemory {
+			issues = append(issues, fmt.Sprintf("requested %d bytes of memory exceeds the limit of %d bytes", in.Memory.Max, l.MaxMemory))
+		}
+		if l.MaxMemory > 0 && in.Memory.Max == 0 {
+			issues = append(issues, fmt.Sprintf("unlimited memory is not allowed, max is %d bytes", l.MaxMemory))
+		}
+	}
+
+	if in.IO != nil && l.MaxIORate > 0 {
+		for _, entry := range in.IO.Max {
+			if entry.Rate > l.MaxIORate {
+				issues = append(issues, fmt.Sprintf("requested %q exceeds the IO rate limit of %d", entry.String(), l.MaxIORate))
+			}
+		}
+	}
+
+	if len(issues) == 0 {
+		return nil
+	}
+	return NewInvalidInputError("invalid resources: %s", strings.Join(issues, ", "))
+}
+
+// resourcesWithDefaults returns requested resources. Not specified controllers are set to DefaultProcResources.
+func resourcesWithDefaults(in *cgroup.Resources) cgroup.Resources {
+	out := DefaultProcResources
+	if in == nil {
+		return out
+	}
+
+	if in.CPU != nil {
+		out.CPU = in.CPU
+	}
+	if in.Memory != nil {
+		out.Memory = in.Memory
+	}
+	if in.IO != nil {
+		out.IO = in.IO
+	}
+	return out
+}
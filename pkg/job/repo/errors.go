@@ -33,3 +33,19 @@ func (e IDCConflictError) Error() string {
 }
 
 func (e IDCConflictError) Conflict() {}
+
+// NameConflictError is returned if Job with a given name is already present in the tenant namespace.
+type NameConflictError struct {
+	tenant string
+	name   string
+}
+
+func NewNameConflictError(tenant, name string) *NameConflictError {
+	return &NameConflictError{tenant: tenant, name: name}
+}
+
+func (e NameConflictError) Error() string {
+	return fmt.Sprintf("Job %q already exists in %q namespace", e.name, e.tenant)
+}
+
+func (e NameConflictError) Conflict() {}
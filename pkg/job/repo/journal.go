@@ -0,0 +1,193 @@
+package repo
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	journalFileName = "jobs.journal"
+	journalFilePerm = 0600
+	// maxJournalEntrySize limits a single journal line. Job definitions are small, so it's just a sanity limit.
+	maxJournalEntrySize = 1024 * 1024
+)
+
+// journalEntry represents a single journal line. The last entry for a given Job wins.
+type journalEntry struct {
+	Job *JobDefinition `json:"job,omitempty"`
+	// Deleted holds the ID of the removed Job. Such entry is dropped on compaction.
+	Deleted string `json:"deleted,omitempty"`
+}
+
+// journal is an append-only file that stores all Job changes, one JSON entry per line.
+// On open, it's compacted, so only the latest state of each Job is preserved.
+type journal struct {
+	file journalFile
+	// broken is set if a failed write couldn't be reverted. The journal ends with a malformed entry,
+	// so no entry can be appended after it, otherwise the journal couldn't be loaded anymore.
+	broken error
+}
+
+// journalFile is implemented by *os.File.
+type journalFile interface {
+	io.WriteCloser
+	Sync() error
+	Truncate(size int64) error
+	Stat() (os.FileInfo, error)
+}
+
+// openJournal loads all Jobs, indexed by ID, stored in a given directory and opens the journal for appending new changes.
+func openJournal(dir string) (*journal, map[string]*JobDefinition, error) {
+	path := filepath.Join(dir, journalFileName)
+
+	jobs, err := loadJournal(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := compactJournal(path, jobs); err != nil {
+		return nil, nil, errors.Wrap(err, "while compacting journal")
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, journalFilePerm)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while opening journal")
+	}
+
+	return &journal{file: f}, jobs, nil
+}
+
+// Append appends a given Job state to the journal and syncs it to the disk.
+func (j *journal) Append(job *JobDefinition) error {
+	return j.write(journalEntry{Job: job})
+}
+
+// AppendDeleted appends removal of a given Job to the journal and syncs it to the disk.
+func (j *journal) AppendDeleted(id string) error {
+	return j.write(journalEntry{Deleted: id})
+}
+
+// write appends a given entry. If it fails, e.g. there is no space left on the device, the partially written entry
+// is truncated, so only the last entry in the journal can be malformed.
+func (j *journal) write(entry journalEntry) error {
+	if j.broken != nil {
+		return errors.Wrap(j.broken, "journal is broken by previous failed write")
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	info, err := j.file.Stat()
+	if err != nil {
+		return errors.Wrap(err, "while getting journal size")
+	}
+
+	_, err = j.file.Write(append(line, '\n'))
+	if err == nil {
+		err = j.file.Sync()
+	}
+	if err != nil {
+		if truncErr := j.file.Truncate(info.Size()); truncErr != nil {
+			j.broken = err
+		}
+		return err
+	}
+	return nil
+}
+
+// Close closes the journal file.
+func (j *journal) Close() error {
+	return j.file.Close()
+}
+
+func loadJournal(path string) (map[string]*JobDefinition, error) {
+	jobs := map[string]*JobDefinition{}
+
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return jobs, nil
+		}
+		return nil, errors.Wrap(err, "while opening journal")
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 4096), maxJournalEntrySize)
+	// malformed holds the error of the previous entry. Only the last entry may be malformed, so it's returned
+	// once any entry follows it.
+	var malformed error
+	for line := 1; scanner.Scan(); line++ {
+		if malformed != nil {
+			return nil, malformed
+		}
+
+		var entry journalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// The last entry may be only partially written if Agent crashed in the middle of the write.
+			// Such entry is skipped, as the change was never confirmed to the caller.
+			malformed = errors.Wrapf(err, "while decoding journal entry in line %d", line)
+			continue
+		}
+		switch {
+		case entry.Job != nil:
+			if entry.Job.ID == "" {
+				// Jobs stored before IDs were introduced are identified by their names.
+				entry.Job.ID = entry.Job.Name
+			}
+			jobs[entry.Job.ID] = entry.Job
+		case entry.Deleted != "":
+			delete(jobs, entry.Deleted)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "while reading journal")
+	}
+
+	return jobs, nil
+}
+
+// compactJournal rewrites the journal, so it contains only a single entry per Job.
+// It's atomic, the old journal is replaced only if the new one was fully written.
+func compactJournal(path string, jobs map[string]*JobDefinition) (err error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), journalFileName+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	for _, job := range jobs {
+		if err = enc.Encode(journalEntry{Job: job}); err != nil {
+			return err
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Chmod(journalFilePerm); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,87 @@
+package repo
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournalFailedWrite(t *testing.T) {
+	tests := map[string]struct {
+		truncateErr error
+		expJobs     []string
+		expErrMsg   string
+	}{
+		"Should truncate partially written entry": {
+			expJobs: []string{"b2c3d4"},
+		},
+		"Should reject appends if partially written entry cannot be truncated": {
+			truncateErr: syscall.EIO,
+			expErrMsg:   "journal is broken by previous failed write: no space left on device",
+		},
+	}
+	for tn, tc := range tests {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+			// given
+			dir := t.TempDir()
+			j, _, err := openJournal(dir)
+			require.NoError(t, err)
+			defer j.Close()
+
+			file := j.file
+			j.file = &failingFile{journalFile: file.(*os.File), truncateErr: tc.truncateErr}
+
+			// when
+			err = j.Append(&JobDefinition{ID: "a1b2c3", Name: "lost"})
+
+			// then
+			assert.EqualError(t, err, "no space left on device")
+
+			// when
+			j.file = file
+			err = j.Append(&JobDefinition{ID: "b2c3d4", Name: "stored"})
+
+			// then
+			if tc.expErrMsg != "" {
+				assert.EqualError(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			jobs, err := loadJournal(filepath.Join(dir, journalFileName))
+			require.NoError(t, err)
+			var ids []string
+			for id := range jobs {
+				ids = append(ids, id)
+			}
+			assert.Equal(t, tc.expJobs, ids)
+		})
+	}
+}
+
+// failingFile writes only half of the data, as if there was no space left on the device.
+type failingFile struct {
+	journalFile
+	truncateErr error
+}
+
+func (f *failingFile) Write(p []byte) (int, error) {
+	n, err := f.journalFile.Write(p[:len(p)/2])
+	if err != nil {
+		return n, err
+	}
+	return n, syscall.ENOSPC
+}
+
+func (f *failingFile) Truncate(size int64) error {
+	if f.truncateErr != nil {
+		return f.truncateErr
+	}
+	return f.journalFile.Truncate(size)
+}
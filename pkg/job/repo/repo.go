@@ -1,24 +1,63 @@
 package repo
 
 import (
-	"os/exec"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/cockroachdb/errors"
+
+	"github.com/mszostok/job-runner/pkg/cgroup"
 )
 
 // JobDefinition represent Job entity.
 // TODO(simplification): In the future, we could introduce DTO <-> Model <-> DSO approach to have better decoupling.
 type JobDefinition struct {
-	// TODO(simplification): In proper scenario Name shouldn't be our ID. Instead we should generate and return associated ID in InsertOutput.
+	// ID is unique across all tenants.
+	ID string `valid:"required"`
+	// Name is unique only within the tenant namespace.
 	Name   string `valid:"required"`
 	Tenant string `valid:"required"`
-	// TODO(simplification): In proper scenario Cmd shouldn't be on InsertInput as it's not possible to store it in external backend. We should have only PID.
-	Cmd         *exec.Cmd `valid:"required"`
-	Status      string    `valid:"required"`
-	ExitCode    int
-	RunFinished chan struct{}
+	// PID is zero while the Job is queued.
+	PID      int
+	Status   string `valid:"required"`
+	ExitCode int
+
+	// CreatedAt specifies when the Job was requested.
+	CreatedAt time.Time
+	// StartedAt specifies when the Job's process was started.
+	StartedAt time.Time
+	// FinishedAt specifies when the Job's process finished. Zero if it is still running or the finish time is unknown.
+	FinishedAt time.Time
+	// TerminationReason specifies why the Job was terminated. Empty if the Job exited on its own.
+	TerminationReason string
+	// Signal specifies the name of the signal that killed the Job's process.
+	Signal string
+	// StoppedBy specifies the tenant that requested the Job stop.
+	StoppedBy string
+	// FinalStats holds resources usage read from the Job's cgroup right before it was removed. Nil if not available.
+	FinalStats *cgroup.GroupStats
+	// Deadline specifies when the Job should be stopped. Zero if the Job has no timeout.
+	Deadline time.Time
+	// DeadlineGracePeriod represents a period of time given to the Job to terminate gracefully once Deadline is exceeded.
+	DeadlineGracePeriod time.Duration
+	// Attempts holds all executions of the Job's command. The last one is the current attempt.
+	Attempts []Attempt
+	// SecurityProfile specifies the name of the profile the Job was hardened with. Empty if it runs unrestricted.
+	SecurityProfile string
+	// Error specifies why the Job's process could not be started. Empty if it was started.
+	Error string
+}
+
+// Attempt represents a single execution of the Job's command.
+type Attempt struct {
+	StartedAt time.Time
+	// FinishedAt is zero if the attempt is still running.
+	FinishedAt time.Time
+	ExitCode   int
+	Signal     string
 }
 
 // Repository contains functionality to manipulate Job objects in repository.
@@ -26,7 +65,11 @@ type Repository struct {
 	// TODO(simplification): this should be an external storage, e.g Redis.
 	// There could be also some shim driver so the backend can be easily swappable.
 	store map[string]*JobDefinition
+	// names holds Job IDs indexed by namespaced name.
+	names map[string]string
 	mu    sync.RWMutex
+	// journal persists all changes if set. Otherwise, Jobs are stored only in memory.
+	journal *journal
 
 	validate func(in interface{}) error
 }
@@ -35,6 +78,7 @@ type Repository struct {
 func NewInMemory() *Repository {
 	return &Repository{
 		store: map[string]*JobDefinition{},
+		names: map[string]string{},
 		validate: func(in interface{}) error {
 			_, err := govalidator.ValidateStruct(in)
 			return err
@@ -42,6 +86,35 @@ func NewInMemory() *Repository {
 	}
 }
 
+// NewOnDisk creates new Repository instance which persists Jobs in a given directory.
+// Jobs stored by previous instances are loaded from disk.
+func NewOnDisk(stateDir string) (*Repository, error) {
+	r := NewInMemory()
+
+	j, jobs, err := openJournal(stateDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening Jobs journal")
+	}
+	for _, job := range jobs {
+		r.store[job.ID] = job
+		r.names[namespacedName(job.Tenant, job.Name)] = job.ID
+	}
+	r.journal = j
+
+	return r, nil
+}
+
+// Shutdown closes underlying persistent storage, if any.
+func (r *Repository) Shutdown() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.journal == nil {
+		return nil
+	}
+	return r.journal.Close()
+}
+
 type InsertInput struct {
 	Job *JobDefinition
 }
@@ -55,17 +128,27 @@ func (r *Repository) Insert(in InsertInput) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, found := r.store[in.Job.Name]; found {
-		return NewIDCConflictError(in.Job.Name)
+	if _, found := r.store[in.Job.ID]; found {
+		return NewIDCConflictError(in.Job.ID)
+	}
+	name := namespacedName(in.Job.Tenant, in.Job.Name)
+	if _, found := r.names[name]; found {
+		return NewNameConflictError(in.Job.Tenant, in.Job.Name)
 	}
 
-	r.store[in.Job.Name] = in.Job
+	job := *in.Job
+	if err := r.persist(&job); err != nil {
+		return err
+	}
+
+	r.store[in.Job.ID] = &job
+	r.names[name] = in.Job.ID
 	return nil
 }
 
 // GetInput contains parameters necessary to execute Get operation on repository.
 type GetInput struct {
-	Name string `valid:"required"`
+	ID string `valid:"required"`
 }
 
 // GetOutput contains parameters returned from Get operation on repository.
@@ -83,9 +166,9 @@ func (r *Repository) Get(in GetInput) (GetOutput, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	job, found := r.store[in.Name]
+	job, found := r.store[in.ID]
 	if !found {
-		return GetOutput{}, NewNotFoundError(in.Name)
+		return GetOutput{}, NewNotFoundError(in.ID)
 	}
 
 	return GetOutput{
@@ -93,12 +176,76 @@ func (r *Repository) Get(in GetInput) (GetOutput, error) {
 	}, nil
 }
 
+// ListInput contains parameters necessary to execute List operation on repository.
+type ListInput struct {
+	// Tenant returns only Jobs created by a given tenant. If empty, Jobs of all tenants are returned.
+	Tenant string
+	// Statuses returns only Jobs in one of a given statuses. If empty, Jobs in all statuses are returned.
+	Statuses []string
+	// NamePrefix returns only Jobs which name starts with a given prefix.
+	NamePrefix string
+	// After returns only Jobs listed after a given one. Use Continue returned by the previous call.
+	After string
+	// Limit specifies the maximum number of returned Jobs. Zero means no limit.
+	Limit int
+}
+
+// ListOutput contains parameters returned from List operation on repository.
+type ListOutput struct {
+	// Jobs holds Jobs sorted by name. Jobs with the same name, but in different tenant namespaces, are sorted by ID.
+	Jobs []*JobDefinition
+	// Continue is set if there are more Jobs to list. Use it as After in the next call.
+	Continue string
+}
+
+// List returns Jobs from repository that match given constraints. It is thread safe.
+func (r *Repository) List(in ListInput) (ListOutput, error) {
+	if err := r.validate(in); err != nil {
+		return ListOutput{}, errors.Wrap(err, "while validating input")
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var jobs []*JobDefinition
+	for _, job := range r.store {
+		if listKey(job) <= in.After || !strings.HasPrefix(job.Name, in.NamePrefix) {
+			continue
+		}
+		if in.Tenant != "" && job.Tenant != in.Tenant {
+			continue
+		}
+		if len(in.Statuses) > 0 && !contains(in.Statuses, job.Status) {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return listKey(jobs[i]) < listKey(jobs[j])
+	})
+
+	out := ListOutput{Jobs: jobs}
+	if in.Limit > 0 && len(jobs) > in.Limit {
+		out.Jobs = jobs[:in.Limit]
+		out.Continue = listKey(out.Jobs[in.Limit-1])
+	}
+
+	return out, nil
+}
+
 // UpdateInput contains parameters necessary to execute Update operation on repository
 type UpdateInput struct {
-	Name string `valid:"required"`
+	ID string `valid:"required"`
 
-	Status   string
-	ExitCode int
+	Status            string
+	ExitCode          int
+	FinishedAt        time.Time
+	TerminationReason string
+	Signal            string
+	StoppedBy         string
+	FinalStats        *cgroup.GroupStats
+	Error             string
 }
 
 // UpdateOutput contains parameters returned from Update operation on repository
@@ -114,45 +261,224 @@ func (r *Repository) Update(in UpdateInput) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	old, found := r.store[in.Name]
+	old, found := r.store[in.ID]
 	if !found {
-		return NewNotFoundError(in.Name)
+		return NewNotFoundError(in.ID)
 	}
-	old.Status = in.Status
-	old.ExitCode = in.ExitCode
-	r.store[in.Name] = old
 
+	// Copy on write, so already returned objects are not modified.
+	job := *old
+	job.Status = in.Status
+	job.ExitCode = in.ExitCode
+	job.FinishedAt = in.FinishedAt
+	job.TerminationReason = in.TerminationReason
+	job.Signal = in.Signal
+	job.StoppedBy = in.StoppedBy
+	job.FinalStats = in.FinalStats
+	job.Error = in.Error
+	if n := len(old.Attempts); n > 0 && !in.FinishedAt.IsZero() {
+		job.Attempts = append([]Attempt(nil), old.Attempts...)
+		job.Attempts[n-1].FinishedAt = in.FinishedAt
+		job.Attempts[n-1].ExitCode = in.ExitCode
+		job.Attempts[n-1].Signal = in.Signal
+	}
+	if err := r.persist(&job); err != nil {
+		return err
+	}
+
+	r.store[in.ID] = &job
 	return nil
 }
 
-// GetJobTenantInput contains parameters necessary to execute GetJobTenant operation on repository.
-type GetJobTenantInput struct {
-	Name string `valid:"required"`
+// RestartInput contains parameters necessary to execute Restart operation on repository.
+type RestartInput struct {
+	ID string `valid:"required"`
+	// PID specifies the process ID of the new attempt.
+	PID int `valid:"required"`
+	// StartedAt specifies when the new attempt was started.
+	StartedAt time.Time
+	// Finished holds the result of the previous attempt.
+	Finished Attempt
 }
 
-// GetJobTenantOutput contains parameters returned from GetJobTenant operation on repository.
-type GetJobTenantOutput struct {
-	Name   string
-	Tenant string
+// Restart records the result of the current Job's attempt and starts a new one.
+func (r *Repository) Restart(in RestartInput) error {
+	if err := r.validate(in); err != nil {
+		return errors.Wrap(err, "while validating input")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	old, found := r.store[in.ID]
+	if !found {
+		return NewNotFoundError(in.ID)
+	}
+
+	// Copy on write, so already returned objects are not modified.
+	job := *old
+	job.PID = in.PID
+	job.Attempts = append([]Attempt(nil), old.Attempts...)
+	if n := len(job.Attempts); n > 0 {
+		job.Attempts[n-1] = in.Finished
+	}
+	job.Attempts = append(job.Attempts, Attempt{StartedAt: in.StartedAt})
+	if err := r.persist(&job); err != nil {
+		return err
+	}
+
+	r.store[in.ID] = &job
+	return nil
 }
 
-// GetJobTenant returns Job's tenant, or NotFoundError.
-// It is thread safe.
-func (r *Repository) GetJobTenant(in GetJobTenantInput) (GetJobTenantOutput, error) {
+// StartInput contains parameters necessary to execute Start operation on repository.
+type StartInput struct {
+	ID     string `valid:"required"`
+	Status string `valid:"required"`
+	// PID specifies the process ID of the first attempt.
+	PID int `valid:"required"`
+	// StartedAt specifies when the first attempt was started.
+	StartedAt time.Time
+	// Deadline specifies when the Job should be stopped. Zero if the Job has no timeout.
+	Deadline time.Time
+	// DeadlineGracePeriod represents a period of time given to the Job to terminate gracefully once Deadline is exceeded.
+	DeadlineGracePeriod time.Duration
+}
+
+// Start records that a queued Job was started. It is thread safe.
+func (r *Repository) Start(in StartInput) error {
 	if err := r.validate(in); err != nil {
-		return GetJobTenantOutput{}, errors.Wrap(err, "while validating input")
+		return errors.Wrap(err, "while validating input")
 	}
 
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	job, found := r.store[in.Name]
+	old, found := r.store[in.ID]
 	if !found {
-		return GetJobTenantOutput{}, NewNotFoundError(in.Name)
+		return NewNotFoundError(in.ID)
 	}
 
-	return GetJobTenantOutput{
-		Name:   job.Name,
+	// Copy on write, so already returned objects are not modified.
+	job := *old
+	job.PID = in.PID
+	job.Status = in.Status
+	job.StartedAt = in.StartedAt
+	job.Deadline = in.Deadline
+	job.DeadlineGracePeriod = in.DeadlineGracePeriod
+	job.Attempts = []Attempt{{StartedAt: in.StartedAt}}
+	if err := r.persist(&job); err != nil {
+		return err
+	}
+
+	r.store[in.ID] = &job
+	return nil
+}
+
+// DeleteInput contains parameters necessary to execute Delete operation on repository.
+type DeleteInput struct {
+	ID string `valid:"required"`
+}
+
+// Delete removes Job from repository, returns NotFoundError in case the object is not found. It is thread safe.
+func (r *Repository) Delete(in DeleteInput) error {
+	if err := r.validate(in); err != nil {
+		return errors.Wrap(err, "while validating input")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	job, found := r.store[in.ID]
+	if !found {
+		return NewNotFoundError(in.ID)
+	}
+
+	if r.journal != nil {
+		if err := r.journal.AppendDeleted(in.ID); err != nil {
+			return errors.Wrap(err, "while persisting Job removal")
+		}
+	}
+
+	delete(r.store, in.ID)
+	delete(r.names, namespacedName(job.Tenant, job.Name))
+	return nil
+}
+
+// ResolveInput contains parameters necessary to execute Resolve operation on repository.
+// Name is resolved within a given tenant namespace first. If it's not found, Job is resolved by ID.
+// Jobs stored before IDs were introduced use names as IDs, so the caller's own Job must take precedence
+// over such Job of other tenant.
+type ResolveInput struct {
+	ID     string
+	Tenant string
+	Name   string
+}
+
+// ResolveOutput contains parameters returned from Resolve operation on repository.
+type ResolveOutput struct {
+	ID     string
+	Tenant string
+	Name   string
+}
+
+// Resolve returns identity of a given Job, or NotFoundError.
+// It is thread safe.
+func (r *Repository) Resolve(in ResolveInput) (ResolveOutput, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var (
+		job   *JobDefinition
+		found bool
+	)
+	if in.Name != "" {
+		job, found = r.store[r.names[namespacedName(in.Tenant, in.Name)]]
+	}
+	if !found && in.ID != "" {
+		job, found = r.store[in.ID]
+	}
+	if !found {
+		ref := in.ID
+		if ref == "" {
+			ref = namespacedName(in.Tenant, in.Name)
+		}
+		return ResolveOutput{}, NewNotFoundError(ref)
+	}
+
+	return ResolveOutput{
+		ID:     job.ID,
 		Tenant: job.Tenant,
+		Name:   job.Name,
 	}, nil
 }
+
+// persist appends a given Job to the journal. Must be called under write lock.
+func (r *Repository) persist(job *JobDefinition) error {
+	if r.journal == nil {
+		return nil
+	}
+	if err := r.journal.Append(job); err != nil {
+		return errors.Wrap(err, "while persisting Job")
+	}
+	return nil
+}
+
+// namespacedName returns Job name prefixed with its tenant, e.g. "Ricky/build".
+func namespacedName(tenant, name string) string {
+	return tenant + "/" + name
+}
+
+// listKey returns key by which Jobs are listed. Names are unique only within tenant namespace, so ID is appended.
+func listKey(job *JobDefinition) string {
+	return job.Name + "\x00" + job.ID
+}
+
+func contains(items []string, exp string) bool {
+	for _, item := range items {
+		if item == exp {
+			return true
+		}
+	}
+	return false
+}
@@ -1,12 +1,15 @@
 package repo_test
 
 import (
-	"os/exec"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mszostok/job-runner/pkg/cgroup"
 	"github.com/mszostok/job-runner/pkg/job/repo"
 )
 
@@ -14,45 +17,49 @@ import (
 func TestInsert_ValidateInput(t *testing.T) {
 	t.Run("failures", func(t *testing.T) {
 		tests := map[string]struct {
+			id     string
 			name   string
 			tenant string
 			status string
-			job    *exec.Cmd
+			pid    int
 
 			expErrMsg string
 		}{
+			"missing ID": {
+				name:   "foo",
+				tenant: "bar",
+				pid:    42,
+				status: "xyz",
+
+				expErrMsg: "while validating input: Job.ID: non zero value required",
+			},
 			"missing Name": {
+				id:     "a1b2c3",
 				tenant: "bar",
-				job:    exec.Command("test"),
+				pid:    42,
 				status: "xyz",
 
 				expErrMsg: "while validating input: Job.Name: non zero value required",
 			},
 			"missing Tenant": {
+				id:     "a1b2c3",
 				name:   "foo",
-				job:    exec.Command("test"),
+				pid:    42,
 				status: "xyz",
 
 				expErrMsg: "while validating input: Job.Tenant: non zero value required",
 			},
-			"missing Cmd": {
-				name:   "foo",
-				tenant: "bar",
-				status: "xyz",
-
-				expErrMsg: "while validating input: Job.Cmd: non zero value required",
-			},
-
 			"missing Status": {
+				id:     "a1b2c3",
 				name:   "foo",
 				tenant: "bar",
-				job:    exec.Command("test"),
+				pid:    42,
 
 				expErrMsg: "while validating input: Job.Status: non zero value required",
 			},
-			"missing Name, Tenant, Status, and Cmd": {
+			"missing ID, Name, Tenant, and Status": {
 				// no fields are set
-				expErrMsg: "while validating input: Job.Cmd: non zero value required;Job.Name: non zero value required;Job.Status: non zero value required;Job.Tenant: non zero value required",
+				expErrMsg: "while validating input: Job.ID: non zero value required;Job.Name: non zero value required;Job.Status: non zero value required;Job.Tenant: non zero value required",
 			},
 		}
 		for tn, tc := range tests {
@@ -64,9 +71,10 @@ func TestInsert_ValidateInput(t *testing.T) {
 				svc := repo.NewInMemory()
 
 				job := &repo.JobDefinition{
+					ID:     tc.id,
 					Name:   tc.name,
 					Tenant: tc.tenant,
-					Cmd:    tc.job,
+					PID:    tc.pid,
 					Status: tc.status,
 				}
 
@@ -87,9 +95,10 @@ func TestInsert_ValidateInput(t *testing.T) {
 		// when
 		err := svc.Insert(repo.InsertInput{
 			Job: &repo.JobDefinition{
+				ID:     "a1b2c3",
 				Name:   "foo",
 				Tenant: "bar",
-				Cmd:    exec.Command("test"),
+				PID:    42,
 				Status: "xyz",
 			},
 		})
@@ -97,6 +106,31 @@ func TestInsert_ValidateInput(t *testing.T) {
 		// then
 		assert.NoError(t, err)
 	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		t.Parallel()
+		// given
+		svc := repo.NewInMemory()
+		require.NoError(t, svc.Insert(repo.InsertInput{
+			Job: &repo.JobDefinition{ID: "a1b2c3", Name: "build", Tenant: "Ricky", Status: "RUNNING"},
+		}))
+
+		// when
+		sameID := svc.Insert(repo.InsertInput{
+			Job: &repo.JobDefinition{ID: "a1b2c3", Name: "probe", Tenant: "Ricky", Status: "RUNNING"},
+		})
+		sameName := svc.Insert(repo.InsertInput{
+			Job: &repo.JobDefinition{ID: "d4e5f6", Name: "build", Tenant: "Ricky", Status: "RUNNING"},
+		})
+		otherNamespace := svc.Insert(repo.InsertInput{
+			Job: &repo.JobDefinition{ID: "d4e5f6", Name: "build", Tenant: "Morty", Status: "RUNNING"},
+		})
+
+		// then
+		assert.EqualError(t, sameID, `Job "a1b2c3" is already present in database`)
+		assert.EqualError(t, sameName, `Job "build" already exists in "Ricky" namespace`)
+		assert.NoError(t, otherNamespace)
+	})
 }
 
 func TestStorageLifecycle(t *testing.T) {
@@ -105,9 +139,10 @@ func TestStorageLifecycle(t *testing.T) {
 	svc := repo.NewInMemory()
 
 	job := &repo.JobDefinition{
+		ID:     "a1b2c3",
 		Name:   "foo",
 		Tenant: "bar",
-		Cmd:    exec.Command("test"),
+		PID:    42,
 		Status: "xyz",
 	}
 
@@ -116,21 +151,20 @@ func TestStorageLifecycle(t *testing.T) {
 	require.NoError(t, err)
 
 	// then
-	out, err := svc.Get(repo.GetInput{Name: job.Name})
+	out, err := svc.Get(repo.GetInput{ID: job.ID})
 	require.NoError(t, err)
 	assert.EqualValues(t, job, out.Job)
 
 	// when
-	tenantOut, err := svc.GetJobTenant(repo.GetJobTenantInput{Name: job.Name})
+	resolveOut, err := svc.Resolve(repo.ResolveInput{Tenant: job.Tenant, Name: job.Name})
 
 	// then
 	require.NoError(t, err)
-	assert.EqualValues(t, tenantOut.Name, job.Name)
-	assert.EqualValues(t, tenantOut.Tenant, job.Tenant)
+	assert.EqualValues(t, repo.ResolveOutput{ID: job.ID, Tenant: job.Tenant, Name: job.Name}, resolveOut)
 
 	// when
 	err = svc.Update(repo.UpdateInput{
-		Name:     job.Name,
+		ID:       job.ID,
 		Status:   "UPDATED",
 		ExitCode: 42,
 	})
@@ -140,8 +174,410 @@ func TestStorageLifecycle(t *testing.T) {
 	expUpdatedJob := *job
 	expUpdatedJob.Status = "UPDATED"
 	expUpdatedJob.ExitCode = 42
-	out, err = svc.Get(repo.GetInput{Name: job.Name})
+	out, err = svc.Get(repo.GetInput{ID: job.ID})
 	require.NoError(t, err)
 	require.NotNil(t, out.Job)
 	assert.EqualValues(t, expUpdatedJob, *out.Job)
 }
+
+func TestRestart(t *testing.T) {
+	// given
+	svc := repo.NewInMemory()
+	started := time.Date(2022, 3, 8, 10, 0, 0, 0, time.UTC)
+	job := &repo.JobDefinition{
+		ID:        "a1b2c3",
+		Name:      "flaky",
+		Tenant:    "Ricky",
+		PID:       42,
+		Status:    "RUNNING",
+		StartedAt: started,
+		Attempts:  []repo.Attempt{{StartedAt: started}},
+	}
+	require.NoError(t, svc.Insert(repo.InsertInput{Job: job}))
+
+	firstAttempt := repo.Attempt{StartedAt: started, FinishedAt: started.Add(time.Second), ExitCode: 1}
+
+	// when
+	err := svc.Restart(repo.RestartInput{
+		ID:        job.ID,
+		PID:       43,
+		StartedAt: started.Add(2 * time.Second),
+		Finished:  firstAttempt,
+	})
+	require.NoError(t, err)
+
+	err = svc.Update(repo.UpdateInput{
+		ID:         job.ID,
+		Status:     "SUCCEEDED",
+		FinishedAt: started.Add(3 * time.Second),
+	})
+	require.NoError(t, err)
+
+	// then
+	out, err := svc.Get(repo.GetInput{ID: job.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 43, out.Job.PID)
+	assert.Equal(t, []repo.Attempt{
+		firstAttempt,
+		{StartedAt: started.Add(2 * time.Second), FinishedAt: started.Add(3 * time.Second)},
+	}, out.Job.Attempts)
+	assert.Equal(t, []repo.Attempt{{StartedAt: started}}, job.Attempts, "inserted object shouldn't be modified")
+
+	// when
+	err = svc.Restart(repo.RestartInput{ID: "not-found", PID: 44})
+
+	// then
+	var notFoundErr *repo.NotFoundError
+	assert.ErrorAs(t, err, &notFoundErr)
+}
+
+func TestStart(t *testing.T) {
+	// given
+	svc := repo.NewInMemory()
+	created := time.Date(2022, 3, 8, 10, 0, 0, 0, time.UTC)
+	job := &repo.JobDefinition{
+		ID:        "a1b2c3",
+		Name:      "queued",
+		Tenant:    "Ricky",
+		Status:    "QUEUED",
+		CreatedAt: created,
+	}
+	require.NoError(t, svc.Insert(repo.InsertInput{Job: job}))
+
+	started := created.Add(time.Minute)
+
+	// when
+	err := svc.Start(repo.StartInput{
+		ID:                  job.ID,
+		Status:              "RUNNING",
+		PID:                 42,
+		StartedAt:           started,
+		Deadline:            started.Add(time.Hour),
+		DeadlineGracePeriod: time.Second,
+	})
+
+	// then
+	require.NoError(t, err)
+	out, err := svc.Get(repo.GetInput{ID: job.ID})
+	require.NoError(t, err)
+	assert.Equal(t, &repo.JobDefinition{
+		ID:                  "a1b2c3",
+		Name:                "queued",
+		Tenant:              "Ricky",
+		PID:                 42,
+		Status:              "RUNNING",
+		CreatedAt:           created,
+		StartedAt:           started,
+		Deadline:            started.Add(time.Hour),
+		DeadlineGracePeriod: time.Second,
+		Attempts:            []repo.Attempt{{StartedAt: started}},
+	}, out.Job)
+	assert.Equal(t, "QUEUED", job.Status, "inserted object shouldn't be modified")
+
+	// when
+	err = svc.Start(repo.StartInput{ID: "not-found", Status: "RUNNING", PID: 44})
+
+	// then
+	var notFoundErr *repo.NotFoundError
+	assert.ErrorAs(t, err, &notFoundErr)
+}
+
+func TestList(t *testing.T) {
+	// given
+	svc := repo.NewInMemory()
+	for _, job := range []*repo.JobDefinition{
+		{ID: "a2", Name: "build-2", Tenant: "Ricky", PID: 42, Status: "RUNNING"},
+		{ID: "a1", Name: "build-1", Tenant: "Ricky", PID: 42, Status: "SUCCEEDED"},
+		{ID: "b1", Name: "probe-1", Tenant: "Morty", PID: 42, Status: "FAILED"},
+		{ID: "b3", Name: "build-3", Tenant: "Morty", PID: 42, Status: "RUNNING"},
+		{ID: "b2", Name: "build-2", Tenant: "Morty", PID: 42, Status: "SUCCEEDED"},
+	} {
+		require.NoError(t, svc.Insert(repo.InsertInput{Job: job}))
+	}
+
+	tests := map[string]struct {
+		in repo.ListInput
+
+		expNames    []string
+		expContinue string
+	}{
+		"Should return all Jobs sorted by name and ID": {
+			in:       repo.ListInput{},
+			expNames: []string{"build-1", "build-2", "build-2", "build-3", "probe-1"},
+		},
+		"Should filter by tenant": {
+			in:       repo.ListInput{Tenant: "Morty"},
+			expNames: []string{"build-2", "build-3", "probe-1"},
+		},
+		"Should filter by statuses": {
+			in:       repo.ListInput{Statuses: []string{"RUNNING", "FAILED"}},
+			expNames: []string{"build-2", "build-3", "probe-1"},
+		},
+		"Should filter by name prefix": {
+			in:       repo.ListInput{NamePrefix: "build-", Tenant: "Ricky"},
+			expNames: []string{"build-1", "build-2"},
+		},
+		"Should return first page": {
+			in:          repo.ListInput{Limit: 2},
+			expNames:    []string{"build-1", "build-2"},
+			expContinue: "build-2\x00a2",
+		},
+		"Should return next page with Jobs of the same name": {
+			in:          repo.ListInput{Limit: 2, After: "build-2\x00a2"},
+			expNames:    []string{"build-2", "build-3"},
+			expContinue: "build-3\x00b3",
+		},
+		"Should return last page": {
+			in:       repo.ListInput{Limit: 2, After: "build-3\x00b3"},
+			expNames: []string{"probe-1"},
+		},
+	}
+	for tn, tc := range tests {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			out, err := svc.List(tc.in)
+
+			// then
+			require.NoError(t, err)
+			var gotNames []string
+			for _, job := range out.Jobs {
+				gotNames = append(gotNames, job.Name)
+			}
+			assert.Equal(t, tc.expNames, gotNames)
+			assert.Equal(t, tc.expContinue, out.Continue)
+		})
+	}
+}
+
+func TestOnDiskStorage(t *testing.T) {
+	t.Parallel()
+	// given
+	dir := t.TempDir()
+
+	svc, err := repo.NewOnDisk(dir)
+	require.NoError(t, err)
+
+	startedAt := time.Date(2022, 3, 8, 10, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(time.Minute)
+
+	job := &repo.JobDefinition{
+		ID:        "a1b2c3",
+		Name:      "foo",
+		Tenant:    "bar",
+		PID:       42,
+		Status:    "RUNNING",
+		CreatedAt: startedAt,
+		StartedAt: startedAt,
+	}
+	require.NoError(t, svc.Insert(repo.InsertInput{Job: job}))
+	require.NoError(t, svc.Update(repo.UpdateInput{
+		ID:                job.ID,
+		Status:            "TERMINATED",
+		ExitCode:          -1,
+		FinishedAt:        finishedAt,
+		TerminationReason: "STOP_REQUESTED",
+		Signal:            "SIGTERM",
+		StoppedBy:         "Ricky",
+		FinalStats:        &cgroup.GroupStats{CPU: cgroup.CPUStats{UsageUsec: 2000}},
+	}))
+	require.NoError(t, svc.Shutdown())
+
+	// when
+	svc, err = repo.NewOnDisk(dir)
+	require.NoError(t, err)
+	defer svc.Shutdown()
+
+	// then
+	out, err := svc.Get(repo.GetInput{ID: job.ID})
+	require.NoError(t, err)
+
+	expJob := *job
+	expJob.Status = "TERMINATED"
+	expJob.ExitCode = -1
+	expJob.FinishedAt = finishedAt
+	expJob.TerminationReason = "STOP_REQUESTED"
+	expJob.Signal = "SIGTERM"
+	expJob.StoppedBy = "Ricky"
+	expJob.FinalStats = &cgroup.GroupStats{CPU: cgroup.CPUStats{UsageUsec: 2000}}
+	assert.Equal(t, expJob, *out.Job)
+}
+
+func TestOnDiskStorageDelete(t *testing.T) {
+	t.Parallel()
+	// given
+	dir := t.TempDir()
+
+	svc, err := repo.NewOnDisk(dir)
+	require.NoError(t, err)
+
+	for id, name := range map[string]string{"a1b2c3": "foo", "d4e5f6": "bar"} {
+		require.NoError(t, svc.Insert(repo.InsertInput{Job: &repo.JobDefinition{
+			ID:     id,
+			Name:   name,
+			Tenant: "Ricky",
+			Status: "SUCCEEDED",
+		}}))
+	}
+
+	// when
+	err = svc.Delete(repo.DeleteInput{ID: "a1b2c3"})
+
+	// then
+	require.NoError(t, err)
+	_, err = svc.Get(repo.GetInput{ID: "a1b2c3"})
+	assert.EqualError(t, err, `Job "a1b2c3" not found`)
+
+	err = svc.Delete(repo.DeleteInput{ID: "a1b2c3"})
+	assert.EqualError(t, err, `Job "a1b2c3" not found`)
+
+	// when
+	require.NoError(t, svc.Shutdown())
+	svc, err = repo.NewOnDisk(dir)
+	require.NoError(t, err)
+	defer svc.Shutdown()
+
+	// then
+	out, err := svc.List(repo.ListInput{})
+	require.NoError(t, err)
+	require.Len(t, out.Jobs, 1)
+	assert.Equal(t, "bar", out.Jobs[0].Name)
+
+	// name of the deleted Job can be reused
+	err = svc.Insert(repo.InsertInput{Job: &repo.JobDefinition{ID: "g7h8i9", Name: "foo", Tenant: "Ricky", Status: "RUNNING"}})
+	assert.NoError(t, err)
+}
+
+func TestResolve(t *testing.T) {
+	// given
+	svc := repo.NewInMemory()
+	for _, job := range []*repo.JobDefinition{
+		{ID: "a1b2c3", Name: "build", Tenant: "Ricky", Status: "RUNNING"},
+		{ID: "d4e5f6", Name: "build", Tenant: "Morty", Status: "RUNNING"},
+		// stored before IDs were introduced, so its ID is the same as its name
+		{ID: "deploy", Name: "deploy", Tenant: "Ricky", Status: "SUCCEEDED"},
+		{ID: "a7b8c9", Name: "deploy", Tenant: "Morty", Status: "RUNNING"},
+	} {
+		require.NoError(t, svc.Insert(repo.InsertInput{Job: job}))
+	}
+
+	tests := map[string]struct {
+		in     repo.ResolveInput
+		expID  string
+		errMsg string
+	}{
+		"Should resolve by ID": {
+			in:    repo.ResolveInput{ID: "d4e5f6", Tenant: "Ricky", Name: "d4e5f6"},
+			expID: "d4e5f6",
+		},
+		"Should resolve name within tenant namespace": {
+			in:    repo.ResolveInput{ID: "build", Tenant: "Morty", Name: "build"},
+			expID: "d4e5f6",
+		},
+		"Should resolve only name if ID is not given": {
+			in:    repo.ResolveInput{Tenant: "Ricky", Name: "build"},
+			expID: "a1b2c3",
+		},
+		"Should prefer name within tenant namespace over ID of other tenant's Job": {
+			in:    repo.ResolveInput{ID: "deploy", Tenant: "Morty", Name: "deploy"},
+			expID: "a7b8c9",
+		},
+		"Should resolve ID of Job stored before IDs were introduced": {
+			in:    repo.ResolveInput{ID: "deploy", Tenant: "Summer", Name: "deploy"},
+			expID: "deploy",
+		},
+		"Should return not found for name in other namespace": {
+			in:     repo.ResolveInput{Tenant: "Summer", Name: "build"},
+			errMsg: `Job "Summer/build" not found`,
+		},
+	}
+	for tn, tc := range tests {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// when
+			out, err := svc.Resolve(tc.in)
+
+			// then
+			if tc.errMsg != "" {
+				assert.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expID, out.ID)
+		})
+	}
+}
+
+func TestOnDiskStorageWithoutIDs(t *testing.T) {
+	t.Parallel()
+	// given
+	dir := t.TempDir()
+	// journal written before IDs were introduced
+	journal := `{"job":{"Name":"legacy","Tenant":"Ricky","Status":"SUCCEEDED"}}` + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "jobs.journal"), []byte(journal), 0600))
+
+	// when
+	svc, err := repo.NewOnDisk(dir)
+	require.NoError(t, err)
+	defer svc.Shutdown()
+
+	// then
+	out, err := svc.Resolve(repo.ResolveInput{Tenant: "Ricky", Name: "legacy"})
+	require.NoError(t, err)
+	assert.Equal(t, "legacy", out.ID)
+}
+
+func TestOnDiskStorageMalformedJournal(t *testing.T) {
+	const (
+		entry     = `{"job":{"ID":"a1b2c3","Name":"build","Tenant":"Ricky","Status":"SUCCEEDED"}}` + "\n"
+		deleted   = `{"deleted":"a1b2c3"}` + "\n"
+		malformed = `{"job":{"ID":"d4e5f6","Na`
+	)
+
+	tests := map[string]struct {
+		journal string
+		expIDs  []string
+		errMsg  string
+	}{
+		"Should skip partially written last entry": {
+			journal: entry + malformed,
+			expIDs:  []string{"a1b2c3"},
+		},
+		"Should fail on malformed entry followed by other ones": {
+			journal: entry + malformed + "\n" + deleted,
+			errMsg:  "while opening Jobs journal: while decoding journal entry in line 2: unexpected end of JSON input",
+		},
+	}
+	for tn, tc := range tests {
+		tc := tc
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+			// given
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "jobs.journal"), []byte(tc.journal), 0600))
+
+			// when
+			svc, err := repo.NewOnDisk(dir)
+
+			// then
+			if tc.errMsg != "" {
+				assert.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			defer svc.Shutdown()
+
+			out, err := svc.List(repo.ListInput{})
+			require.NoError(t, err)
+			var ids []string
+			for _, job := range out.Jobs {
+				ids = append(ids, job.ID)
+			}
+			assert.Equal(t, tc.expIDs, ids)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package job
+
+import "time"
+
+const (
+	// DefaultRestartBackoff represents a default delay before the first restart. It's doubled after each restart.
+	DefaultRestartBackoff = time.Second
+	// MaxRestartBackoff represents the maximum delay between restarts.
+	MaxRestartBackoff = 5 * time.Minute
+)
+
+// RestartPolicy specifies when Cmd is restarted after its process exited.
+type RestartPolicy string
+
+const (
+	// RestartNever indicates that Cmd is never restarted.
+	RestartNever RestartPolicy = "NEVER"
+	// RestartOnFailure indicates that Cmd is restarted only if it didn't succeed.
+	RestartOnFailure RestartPolicy = "ON_FAILURE"
+	// RestartAlways indicates that Cmd is restarted regardless of its exit code.
+	RestartAlways RestartPolicy = "ALWAYS"
+)
+
+// RestartOptions holds Cmd's restart configuration.
+type RestartOptions struct {
+	// Policy specifies when Cmd is restarted. Empty means RestartNever.
+	Policy RestartPolicy
+	// MaxRestarts specifies the maximum number of restarts. Zero means no limit.
+	MaxRestarts int
+	// Backoff specifies the delay before the first restart. It's doubled after each restart up to MaxRestartBackoff.
+	// Zero means DefaultRestartBackoff.
+	Backoff time.Duration
+}
+
+// Validate returns error if options are not valid.
+func (o RestartOptions) Validate() error {
+	switch o.Policy {
+	case "", RestartNever, RestartOnFailure, RestartAlways:
+	default:
+		return NewInvalidInputError("unknown restart policy %q", o.Policy)
+	}
+	if o.MaxRestarts < 0 {
+		return NewInvalidInputError("max restarts cannot be negative")
+	}
+	if o.Backoff < 0 {
+		return NewInvalidInputError("restart backoff cannot be negative")
+	}
+	return nil
+}
+
+// shouldRestart returns true if Cmd which finished with a given status should be restarted.
+func (o RestartOptions) shouldRestart(status Status, restarts int) bool {
+	if o.MaxRestarts > 0 && restarts >= o.MaxRestarts {
+		return false
+	}
+
+	switch o.Policy {
+	case RestartAlways:
+		return true
+	case RestartOnFailure:
+		return status != Succeeded
+	default:
+		return false
+	}
+}
+
+// backoff returns the delay before the next restart.
+func (o RestartOptions) backoff(restarts int) time.Duration {
+	delay := o.Backoff
+	if delay == 0 {
+		delay = DefaultRestartBackoff
+	}
+	for i := 0; i < restarts && delay < MaxRestartBackoff; i++ {
+		delay *= 2
+	}
+	if delay > MaxRestartBackoff {
+		delay = MaxRestartBackoff
+	}
+	return delay
+}
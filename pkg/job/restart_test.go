@@ -0,0 +1,75 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartOptionsShouldRestart(t *testing.T) {
+	tests := map[string]struct {
+		opts     RestartOptions
+		status   Status
+		restarts int
+		expected bool
+	}{
+		"Should not restart by default": {
+			opts:     RestartOptions{},
+			status:   Failed,
+			expected: false,
+		},
+		"Should restart failed Job on failure": {
+			opts:     RestartOptions{Policy: RestartOnFailure},
+			status:   Failed,
+			expected: true,
+		},
+		"Should restart terminated Job on failure": {
+			opts:     RestartOptions{Policy: RestartOnFailure},
+			status:   Terminated,
+			expected: true,
+		},
+		"Should not restart succeeded Job on failure": {
+			opts:     RestartOptions{Policy: RestartOnFailure},
+			status:   Succeeded,
+			expected: false,
+		},
+		"Should always restart succeeded Job": {
+			opts:     RestartOptions{Policy: RestartAlways},
+			status:   Succeeded,
+			restarts: 100,
+			expected: true,
+		},
+		"Should not restart if max restarts is reached": {
+			opts:     RestartOptions{Policy: RestartAlways, MaxRestarts: 3},
+			status:   Failed,
+			restarts: 3,
+			expected: false,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			got := tc.opts.shouldRestart(tc.status, tc.restarts)
+
+			// then
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestRestartOptionsBackoff(t *testing.T) {
+	// given
+	opts := RestartOptions{Backoff: 100 * time.Millisecond}
+
+	// when
+	var got []time.Duration
+	for restarts := 0; restarts < 4; restarts++ {
+		got = append(got, opts.backoff(restarts))
+	}
+
+	// then
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond}, got)
+	assert.Equal(t, DefaultRestartBackoff, RestartOptions{}.backoff(0))
+	assert.Equal(t, MaxRestartBackoff, opts.backoff(1000))
+}
@@ -0,0 +1,157 @@
+package job
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/mszostok/job-runner/internal/shutdown"
+	"github.com/mszostok/job-runner/pkg/job/repo"
+)
+
+var _ shutdown.ShutdownableService = &RetentionCollector{}
+
+// DefaultRetentionInterval represents a default period of time between retention policy runs.
+const DefaultRetentionInterval = time.Minute
+
+// finishedStatuses holds statuses of Cmds which can be deleted.
+var finishedStatuses = []string{string(Succeeded), string(Failed), string(Terminated), string(Lost)}
+
+// RetentionPolicy specifies how long finished Cmds are kept. Cmds are deleted together with their logs.
+type RetentionPolicy struct {
+	// MaxAge specifies how long a finished Cmd is kept. Zero means no limit.
+	MaxAge time.Duration
+	// MaxCountPerTenant specifies the maximum number of finished Cmds kept for a single tenant. The oldest ones are deleted first.
+	// Zero means no limit.
+	MaxCountPerTenant int
+	// Interval specifies how often the policy is applied. Zero means DefaultRetentionInterval.
+	Interval time.Duration
+}
+
+// Validate returns error if policy is not valid.
+func (p RetentionPolicy) Validate() error {
+	if p.MaxAge < 0 || p.MaxCountPerTenant < 0 || p.Interval < 0 {
+		return NewInvalidInputError("retention max age, max count and interval cannot be negative")
+	}
+	return nil
+}
+
+// Enabled returns true if policy limits finished Cmds in any way.
+func (p RetentionPolicy) Enabled() bool {
+	return p.MaxAge > 0 || p.MaxCountPerTenant > 0
+}
+
+// RetentionCollector periodically deletes finished Jobs according to the retention policy.
+type RetentionCollector struct {
+	jobs   *Service
+	policy RetentionPolicy
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewRetentionCollector returns a new RetentionCollector instance. Policy is applied in the background until Shutdown is called.
+func NewRetentionCollector(jobs *Service, policy RetentionPolicy) (*RetentionCollector, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	if policy.Interval == 0 {
+		policy.Interval = DefaultRetentionInterval
+	}
+
+	c := &RetentionCollector{
+		jobs:   jobs,
+		policy: policy,
+		stop:   make(chan struct{}),
+	}
+
+	c.wg.Add(1)
+	go c.run()
+
+	return c, nil
+}
+
+// Shutdown stops applying the retention policy. It waits until the current run, if any, is done.
+func (c *RetentionCollector) Shutdown() error {
+	close(c.stop)
+	c.wg.Wait()
+	return nil
+}
+
+func (c *RetentionCollector) run() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.policy.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			// Jobs that cannot be deleted are retried on the next run.
+			// TODO(simplification): handle error, e.g. log it (zap/logrus)
+			_ = c.Collect(context.Background())
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// Collect deletes finished Jobs which exceed the retention policy.
+func (c *RetentionCollector) Collect(ctx context.Context) error {
+	out, err := c.jobs.jobStorage.List(repo.ListInput{Statuses: finishedStatuses})
+	if err != nil {
+		return errors.Wrap(err, "while listing finished Jobs")
+	}
+
+	var result *multierror.Error
+	for _, id := range c.expired(out.Jobs, time.Now()) {
+		_, err := c.jobs.Delete(ctx, DeleteInput{ID: id})
+		switch {
+		case err == nil, IsNotFoundError(err): // may be already deleted by tenant
+		case errors.As(err, new(*LostProcessesRunningError)): // lost Job is kept until its processes exit, they are never killed
+		default:
+			result = multierror.Append(result, errors.Wrapf(err, "while deleting Job %q", id))
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+// expired returns IDs of given finished Jobs which exceed the retention policy.
+func (c *RetentionCollector) expired(jobs []*repo.JobDefinition, now time.Time) []string {
+	perTenant := map[string][]*repo.JobDefinition{}
+	for _, job := range jobs {
+		perTenant[job.Tenant] = append(perTenant[job.Tenant], job)
+	}
+
+	var out []string
+	for _, tenantJobs := range perTenant {
+		// the most recently finished first
+		sort.SliceStable(tenantJobs, func(i, j int) bool {
+			return finishedAt(tenantJobs[i]).After(finishedAt(tenantJobs[j]))
+		})
+		for idx, job := range tenantJobs {
+			tooMany := c.policy.MaxCountPerTenant > 0 && idx >= c.policy.MaxCountPerTenant
+			tooOld := c.policy.MaxAge > 0 && now.Sub(finishedAt(job)) > c.policy.MaxAge
+			if tooMany || tooOld {
+				out = append(out, job.ID)
+			}
+		}
+	}
+	return out
+}
+
+// finishedAt returns when a given Job finished. Finish time of lost and canceled Jobs may be unknown,
+// so the time the Job was started or requested is used instead.
+func finishedAt(job *repo.JobDefinition) time.Time {
+	switch {
+	case !job.FinishedAt.IsZero():
+		return job.FinishedAt
+	case !job.StartedAt.IsZero():
+		return job.StartedAt
+	default:
+		return job.CreatedAt
+	}
+}
@@ -2,30 +2,57 @@ package job
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"io"
+	"io/fs"
+	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"golang.org/x/sys/unix"
 
 	"github.com/mszostok/job-runner/internal/shutdown"
 	"github.com/mszostok/job-runner/pkg/cgroup"
 	"github.com/mszostok/job-runner/pkg/file"
 	"github.com/mszostok/job-runner/pkg/job/repo"
+	"github.com/mszostok/job-runner/pkg/security"
 )
 
 var _ shutdown.ShutdownableService = &Service{}
 
 const cgroupDefaultParentName = "LPR"
 
+// DefaultShutdownGracePeriod represents a default period of time given to running Jobs to terminate gracefully on Service shutdown.
+const DefaultShutdownGracePeriod = 10 * time.Second
+
+// exitPollInterval represents how often the Job's cgroup is checked for processes that are still running.
+const exitPollInterval = 10 * time.Millisecond
+
+// ErrShuttingDown is returned when a new Job is requested during Service shutdown.
+var ErrShuttingDown = errors.New("service is shutting down, cannot run new Jobs")
+
+// errCloneIntoCgroupNotSupported is returned if the process cannot be started directly in cgroup.
+var errCloneIntoCgroupNotSupported = errors.New("starting process directly in cgroup is not supported")
+
 type Storage interface {
 	Insert(in repo.InsertInput) error
 	Get(in repo.GetInput) (repo.GetOutput, error)
+	List(in repo.ListInput) (repo.ListOutput, error)
 	Update(in repo.UpdateInput) error
+	Restart(in repo.RestartInput) error
+	Start(in repo.StartInput) error
+	Delete(in repo.DeleteInput) error
+	Resolve(in repo.ResolveInput) (repo.ResolveOutput, error)
 }
 
 type FileLogger interface {
@@ -35,65 +62,561 @@ type FileLogger interface {
 
 // Service provides functionality to run/stop/watch arbitrary Linux processes.
 type Service struct {
-	jobStorage Storage
-	fileLogger *file.Logger
+	jobStorage      Storage
+	fileLogger      *file.Logger
+	resourcesLimits ResourcesLimits
+	cgroupEnabled   bool
 
-	stopMux       sync.Mutex
-	createProcCmd func(in RunInput, sink io.Writer) (*exec.Cmd, error)
+	// processes holds *process entries for Jobs started by this Service instance, indexed by Job ID.
+	processes           sync.Map
+	stopMux             sync.Mutex
+	shuttingDown        int32 // accessed atomically, 1 if Shutdown was called
+	shutdownGracePeriod time.Duration
+	// orphanDeadlines holds timers enforcing deadlines of Jobs started by previous Agent instance.
+	orphanDeadlines []*time.Timer
+	startProc       func(in RunInput, stdio *stdio) (*jobCmd, error)
+	// admission queues Jobs requested above the concurrency limits.
+	admission *admission
+	// identityPolicy restricts users and groups Jobs can run as. Nil means no restrictions.
+	identityPolicy *IdentityPolicy
+	// defaultIsolation is used for Jobs requested without isolation.
+	defaultIsolation Isolation
+	// rootfs provides root filesystems of images. Nil means that Jobs can run only in the host root filesystem.
+	rootfs RootfsProvider
+	// securityProfiles holds profiles Jobs can be hardened with. Nil means that Jobs run unrestricted.
+	securityProfiles *security.Profiles
+}
+
+// RootfsProvider provides root filesystems Jobs can run in.
+type RootfsProvider interface {
+	// RootfsPath returns path of the root filesystem of a given image.
+	RootfsPath(name string) (string, error)
+}
+
+// process represents a Linux process started by Service. It cannot be persisted, so it's kept only in memory.
+type process struct {
+	// in holds the input used to start the process, so it can be restarted.
+	in RunInput
+	// stdio holds the process standard streams. They are shared by all attempts.
+	stdio *stdio
+	// cgroupPath is the path of the Job's cgroup. Empty if Jobs are not executed in dedicated cgroups.
+	cgroupPath string
+	// deadline stops the process once its timeout is exceeded. Nil if process has no timeout.
+	deadline *time.Timer
+	// runFinished is closed when process exited, its final status was stored and its resources were released.
+	// NOTE: We cannot use `cmd.Wait` multiple times, so we need to use dedicated channel
+	// to inform others about finished cmd.
+	runFinished chan struct{}
+	// stopRequested is closed when stop is requested, so it can interrupt waiting for restart.
+	stopRequested chan struct{}
+
+	// Fields below are accessed only by the goroutine watching the process.
+	attemptStartedAt time.Time
+	restarts         int
+	// oomKills is the number of OOM kills in the Job's cgroup before the current attempt was started.
+	oomKills uint64
+
+	// mu guards fields below. The cmd is replaced when the process is restarted.
+	mu            sync.Mutex
+	cmd           *jobCmd
+	stopReason    TerminationReason
+	stopRequester string
+}
+
+// requestStop records why the process is being stopped. Only the first request is recorded.
+func (p *process) requestStop(reason TerminationReason, requester string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopReason != "" {
+		return
+	}
+	p.stopReason, p.stopRequester = reason, requester
+	close(p.stopRequested)
 }
 
+// finished returns true if the process exited and its resources were released.
+func (p *process) finished() bool {
+	select {
+	case <-p.runFinished:
+		return true
+	default:
+		return false
+	}
+}
+
+// stopRequest returns the recorded stop reason and requester. Reason is empty if stop was not requested.
+func (p *process) stopRequest() (TerminationReason, string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.stopReason, p.stopRequester
+}
+
+// currentCmd returns the cmd of the current attempt.
+func (p *process) currentCmd() *jobCmd {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.cmd
+}
+
+// NewService returns a new Service instance.
+// Jobs which are stored as running, but were not started by this instance (e.g. before Agent restart) are marked as lost.
 func NewService(jobStorage Storage, logger *file.Logger, opts ...ServiceOption) (*Service, error) {
 	svc := &Service{
-		jobStorage:    jobStorage,
-		fileLogger:    logger,
-		createProcCmd: wrapProcForChildExecution,
+		jobStorage:          jobStorage,
+		fileLogger:          logger,
+		startProc:           startInJobCgroup,
+		cgroupEnabled:       true,
+		shutdownGracePeriod: DefaultShutdownGracePeriod,
+		admission:           newAdmission(AdmissionLimits{}),
+		defaultIsolation:    IsolationHost,
 	}
 
 	for _, option := range opts {
 		option(svc)
 	}
 
+	if err := svc.admission.limits.Validate(); err != nil {
+		return nil, err
+	}
+	if err := svc.validateIsolation(svc.defaultIsolation); err != nil {
+		return nil, errors.Wrap(err, "while validating default isolation")
+	}
+	if err := svc.validateSecurityProfiles(); err != nil {
+		return nil, errors.Wrap(err, "while validating security profiles")
+	}
+
+	if err := svc.markOrphanedJobs(); err != nil {
+		return nil, errors.Wrap(err, "while marking orphaned Jobs")
+	}
+
 	return svc, nil
 }
 
 func (l *Service) Run(_ context.Context, in RunInput) (*RunOutput, error) {
-	sink, releaseSink, err := l.fileLogger.NewSink(in.Name)
+	if atomic.LoadInt32(&l.shuttingDown) == 1 {
+		return nil, ErrShuttingDown
+	}
+
+	createdAt := time.Now()
+
+	if err := l.resolveName(&in); err != nil {
+		return nil, err
+	}
+	if in.Timeout < 0 || in.TimeoutGracePeriod < 0 {
+		return nil, NewInvalidInputError("timeout and timeout grace period cannot be negative")
+	}
+	if err := in.Restart.Validate(); err != nil {
+		return nil, err
+	}
+	if err := l.validateProcessSettings(in); err != nil {
+		return nil, err
+	}
+	if in.Isolation == "" {
+		in.Isolation = l.defaultIsolation
+	}
+	if err := l.validateIsolation(in.Isolation); err != nil {
+		return nil, err
+	}
+	rootfsPath, err := l.resolveRootfs(in)
+	if err != nil {
+		return nil, err
+	}
+	in.rootfsPath = rootfsPath
+	if err := l.resolveSecurityProfile(&in); err != nil {
+		return nil, err
+	}
+	cred, err := resolveCredential(in, l.identityPolicy)
 	if err != nil {
-		return nil, errors.Wrap(err, "cannot create log sink")
+		return nil, err
+	}
+	in.credential = cred
+
+	resources := resourcesWithDefaults(in.Resources)
+	if err := l.resourcesLimits.Validate(resources); err != nil {
+		return nil, err
 	}
+	in.Resources = &resources
 
-	cmd, err := l.createProcCmd(in, sink)
+	id, err := newID()
 	if err != nil {
-		return nil, errors.Wrap(err, "while wrapping for child proc execution")
+		return nil, err
 	}
+	in.id = id
 
-	job := &repo.JobDefinition{
-		Name:        in.Name,
-		Tenant:      in.Tenant,
-		Cmd:         cmd,
-		RunFinished: make(chan struct{}),
-		Status:      string(Running),
-	}
-	// TODO(simplification): we should think when we want to delete it. We could:
-	// - add `defer` to remove stored Job in case of any error in the below steps,
-	// - or preserve it so it could be later fetch via `Get`,
-	//   - and may add some sanity "cron job" that will care of cleanup such "orphans"
-	if err := l.jobStorage.Insert(repo.InsertInput{Job: job}); err != nil {
-		return nil, errors.Wrap(err, "while storing Job")
+	l.admission.mu.Lock()
+	if !l.admission.canStartLocked(in.Tenant) {
+		defer l.admission.mu.Unlock()
+		return l.enqueueLocked(in, createdAt)
+	}
+	l.admission.acquireLocked(in.Tenant)
+	l.admission.mu.Unlock()
+
+	if err := l.start(in, createdAt, false); err != nil {
+		l.releaseAdmission(in.Tenant)
+		return nil, err
+	}
+	return &RunOutput{ID: in.id, Name: in.Name}, nil
+}
+
+// resolveName validates the requested name, or generates one from the requested prefix. Name availability is checked
+// before the Job is started, so the process is not started in vain. Uniqueness is enforced by storage anyway.
+func (l *Service) resolveName(in *RunInput) error {
+	switch {
+	case in.Name != "" && in.GenerateName != "":
+		return NewInvalidInputError("name and generate name are mutually exclusive")
+	case in.Name != "":
+		if err := validateName(in.Name); err != nil {
+			return err
+		}
+		return l.checkNameAvailable(in.Tenant, in.Name)
+	case in.GenerateName == "":
+		return NewInvalidInputError("name or generate name is required")
+	case strings.Contains(in.GenerateName, namespaceSeparator):
+		return NewInvalidInputError("generate name %q cannot contain %q", in.GenerateName, namespaceSeparator)
+	}
+
+	var err error
+	for attempt := 0; attempt < maxGenerateNameAttempts; attempt++ {
+		var name string
+		name, err = generateName(in.GenerateName)
+		if err != nil {
+			return err
+		}
+		if err = validateName(name); err != nil {
+			continue
+		}
+		if err = l.checkNameAvailable(in.Tenant, name); err != nil {
+			continue
+		}
+		in.Name = name
+		return nil
+	}
+	return errors.Wrapf(err, "while generating name with prefix %q", in.GenerateName)
+}
+
+// checkNameAvailable returns conflict error if a given tenant already has Job with a given name.
+func (l *Service) checkNameAvailable(tenant, name string) error {
+	_, err := l.jobStorage.Resolve(repo.ResolveInput{Tenant: tenant, Name: name})
+	switch {
+	case err == nil:
+		return repo.NewNameConflictError(tenant, name)
+	case IsNotFoundError(err):
+		return nil
+	default:
+		return errors.Wrap(err, "while checking name availability")
+	}
+}
+
+// validateProcessSettings returns error if the working directory or umask cannot be applied.
+func (l *Service) validateProcessSettings(in RunInput) error {
+	if in.WorkingDir != "" && !filepath.IsAbs(in.WorkingDir) {
+		return NewInvalidInputError("working directory %q must be an absolute path", in.WorkingDir)
+	}
+	if in.Umask == "" {
+		return nil
+	}
+	if _, err := parseUmask(in.Umask); err != nil {
+		return err
+	}
+	if !l.cgroupEnabled {
+		return NewInvalidInputError("umask can be set only if Jobs are executed in dedicated cgroups")
+	}
+	return nil
+}
+
+// resolveRootfs returns path of the requested root filesystem. Returns empty path if Job runs in the host one.
+func (l *Service) resolveRootfs(in RunInput) (string, error) {
+	for _, path := range in.ReadOnlyPaths {
+		if !filepath.IsAbs(path) {
+			return "", NewInvalidInputError("read-only path %q must be an absolute path", path)
+		}
+	}
+	if in.Rootfs == "" {
+		if len(in.ReadOnlyPaths) > 0 {
+			return "", NewInvalidInputError("read-only paths can be used only together with rootfs")
+		}
+		return "", nil
+	}
+
+	if l.rootfs == nil {
+		return "", NewInvalidInputError("rootfs images are not enabled on Agent")
+	}
+	if !in.Isolation.Namespaced() {
+		return "", NewInvalidInputError("rootfs can be used only with %s or %s isolation", IsolationNamespaces, IsolationNamespacesWithNetwork)
+	}
+
+	path, err := l.rootfs.RootfsPath(in.Rootfs)
+	switch {
+	case IsNotFoundError(err):
+		return "", NewInvalidInputError("rootfs image %q not found", in.Rootfs)
+	case err != nil:
+		return "", errors.Wrap(err, "while resolving rootfs")
+	}
+	return path, nil
+}
+
+// validateIsolation returns error if a given isolation is unknown or cannot be set up.
+func (l *Service) validateIsolation(isolation Isolation) error {
+	if err := isolation.Validate(); err != nil {
+		return err
+	}
+	if isolation.Namespaced() && !l.cgroupEnabled {
+		return NewInvalidInputError("isolation %s can be used only if Jobs are executed in dedicated cgroups", isolation)
+	}
+	return nil
+}
+
+func (l *Service) validateSecurityProfiles() error {
+	if l.securityProfiles == nil {
+		return nil
 	}
+	if err := l.securityProfiles.Validate(); err != nil {
+		return err
+	}
+	if l.securityProfiles.Default != "" && !l.cgroupEnabled {
+		return NewInvalidInputError("default profile can be used only if Jobs are executed in dedicated cgroups")
+	}
+	return nil
+}
 
-	if err := cmd.Start(); err != nil {
-		return nil, errors.Wrap(err, "while starting Job")
+// resolveSecurityProfile sets the requested profile, or the default one, on a given input. Profile is left empty
+// if Job runs unrestricted.
+func (l *Service) resolveSecurityProfile(in *RunInput) error {
+	if in.SecurityProfile == "" && l.securityProfiles != nil {
+		in.SecurityProfile = l.securityProfiles.Default
+	}
+	if in.SecurityProfile == "" {
+		return nil
 	}
 
-	go l.watchRunningProcess(job, func() error {
-		// NOTE: We cannot use `cmd.Wait` multiple times, so we need to use dedicated channel
-		// to inform others about finished cmd.
-		close(job.RunFinished)
+	if l.securityProfiles == nil {
+		return NewInvalidInputError("security profiles are not configured on Agent")
+	}
+	profile, found := l.securityProfiles.Get(in.SecurityProfile)
+	if !found {
+		return NewInvalidInputError("unknown security profile %q", in.SecurityProfile)
+	}
+	if !l.cgroupEnabled {
+		return NewInvalidInputError("security profiles can be used only if Jobs are executed in dedicated cgroups")
+	}
+	in.securityProfile = &profile
+	return nil
+}
+
+// enqueueLocked stores a given Job as queued. It's started once running Jobs finish and the limits allow.
+// Must be called under admission lock.
+func (l *Service) enqueueLocked(in RunInput, createdAt time.Time) (*RunOutput, error) {
+	// checked under lock, so Shutdown doesn't miss any queued Job
+	if atomic.LoadInt32(&l.shuttingDown) == 1 {
+		return nil, ErrShuttingDown
+	}
+
+	err := l.jobStorage.Insert(repo.InsertInput{
+		Job: &repo.JobDefinition{
+			ID:              in.id,
+			Name:            in.Name,
+			Tenant:          in.Tenant,
+			Status:          string(Queued),
+			CreatedAt:       createdAt,
+			SecurityProfile: in.SecurityProfile,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "while storing Job")
+	}
+
+	l.admission.enqueueLocked(in)
+	return &RunOutput{ID: in.id, Name: in.Name}, nil
+}
+
+// start starts the Job's process and watches it until it finishes. Queued Job is already stored,
+// so only its status is updated.
+func (l *Service) start(in RunInput, createdAt time.Time, queued bool) error {
+	sink, releaseSink, err := l.fileLogger.NewSink(in.id)
+	if err != nil {
+		return errors.Wrap(err, "cannot create log sink")
+	}
+	stdio, err := newStdio(in, sink)
+	if err != nil {
+		_ = releaseSink()
+		return err
+	}
+	release := func() error {
+		stdio.close()
 		return releaseSink()
+	}
+
+	cmd, err := l.startProc(in, stdio)
+	if err != nil {
+		_ = release()
+		return errors.Wrap(err, "while starting Job")
+	}
+
+	proc := &process{
+		in:               in,
+		stdio:            stdio,
+		cmd:              cmd,
+		runFinished:      make(chan struct{}),
+		stopRequested:    make(chan struct{}),
+		attemptStartedAt: time.Now(),
+	}
+	if l.cgroupEnabled {
+		proc.cgroupPath = getJobCgroupPath(in.id)
+	}
+	l.processes.Store(in.id, proc)
+
+	job := &repo.JobDefinition{
+		ID:              in.id,
+		Name:            in.Name,
+		Tenant:          in.Tenant,
+		PID:             cmd.Process.Pid,
+		Status:          string(Running),
+		CreatedAt:       createdAt,
+		StartedAt:       proc.attemptStartedAt,
+		Attempts:        []repo.Attempt{{StartedAt: proc.attemptStartedAt}},
+		SecurityProfile: in.SecurityProfile,
+	}
+	if in.Timeout > 0 {
+		// deadline is persisted, so it can be enforced also after Agent restart
+		job.Deadline = job.StartedAt.Add(in.Timeout)
+		job.DeadlineGracePeriod = in.TimeoutGracePeriod
+	}
+	if err := l.storeStarted(job, queued); err != nil {
+		// Job cannot be tracked, so it shouldn't run at all.
+		_ = cmd.Process.Kill()
+		_ = cmd.wait()
+		l.processes.Delete(in.id)
+		_ = release()
+		return errors.Wrap(err, "while storing Job")
+	}
+
+	if in.Timeout > 0 {
+		proc.deadline = time.AfterFunc(in.Timeout, func() {
+			proc.requestStop(DeadlineExceeded, "")
+			l.terminate(proc, syscall.SIGTERM, in.TimeoutGracePeriod)
+		})
+	}
+
+	go l.watchRunningProcess(job.ID, proc, release)
+
+	return nil
+}
+
+func (l *Service) storeStarted(job *repo.JobDefinition, queued bool) error {
+	if !queued {
+		return l.jobStorage.Insert(repo.InsertInput{Job: job})
+	}
+	return l.jobStorage.Start(repo.StartInput{
+		ID:                  job.ID,
+		Status:              job.Status,
+		PID:                 job.PID,
+		StartedAt:           job.StartedAt,
+		Deadline:            job.Deadline,
+		DeadlineGracePeriod: job.DeadlineGracePeriod,
+	})
+}
+
+// releaseAdmission frees the slot of a finished Job, and starts queued Jobs as long as the limits allow.
+func (l *Service) releaseAdmission(tenant string) {
+	l.admission.mu.Lock()
+	l.admission.releaseLocked(tenant)
+	l.admission.mu.Unlock()
+
+	l.startQueued()
+}
+
+// startQueued starts queued Jobs as long as the limits allow. The admission lock is held only to dequeue a Job,
+// so starting its process doesn't block other Jobs.
+func (l *Service) startQueued() {
+	for atomic.LoadInt32(&l.shuttingDown) == 0 {
+		l.admission.mu.Lock()
+		next := l.admission.dequeueNextLocked()
+		if next == nil {
+			l.admission.mu.Unlock()
+			return
+		}
+		l.admission.acquireLocked(next.in.Tenant)
+		l.admission.mu.Unlock()
+
+		err := l.start(next.in, time.Time{}, true)
+		if err != nil {
+			// stored before the Job leaves the starting state, so the waiting callers get the final status
+			l.markStartFailed(next.in.id, err)
+		}
+
+		l.admission.mu.Lock()
+		if err != nil {
+			l.admission.releaseLocked(next.in.Tenant)
+		}
+		l.admission.startedLocked(next)
+		l.admission.mu.Unlock()
+	}
+}
+
+// markStartFailed stores a given queued Job as failed, together with the reason its process could not be started.
+func (l *Service) markStartFailed(id string, startErr error) {
+	err := l.jobStorage.Update(repo.UpdateInput{
+		ID:                id,
+		Status:            string(Failed),
+		FinishedAt:        time.Now(),
+		TerminationReason: string(StartFailed),
+		Error:             startErr.Error(),
+	})
+	if err != nil {
+		// nobody waits for the result, so it can be only logged
+		log.Printf("Cannot store start failure of Job %q: %v, start error: %v\n", id, err, startErr)
+	}
+}
+
+// cancelQueued removes a given Job from the queue and marks it as terminated, so it's never started.
+// Returns false if the Job is not queued.
+func (l *Service) cancelQueued(id string, reason TerminationReason, stoppedBy string) (bool, error) {
+	l.admission.mu.Lock()
+	defer l.admission.mu.Unlock()
+
+	item, found := l.admission.removeLocked(id)
+	if !found {
+		return false, nil
+	}
+	defer close(item.dequeued)
+
+	err := l.jobStorage.Update(repo.UpdateInput{
+		ID:                id,
+		Status:            string(Terminated),
+		FinishedAt:        time.Now(),
+		TerminationReason: string(reason),
+		StoppedBy:         stoppedBy,
 	})
+	if err != nil {
+		return true, errors.Wrap(err, "while updating Job")
+	}
+	return true, nil
+}
 
-	return &RunOutput{}, nil
+// lookup returns the process or the queue entry of a given Job. Both are nil if the Job is neither running nor queued.
+func (l *Service) lookup(id string) (*process, *queuedCmd) {
+	l.admission.mu.Lock()
+	defer l.admission.mu.Unlock()
+
+	if item, found := l.admission.getLocked(id); found {
+		return nil, item
+	}
+	proc, _ := l.getProcess(id)
+	return proc, nil
+}
+
+// waitUntilDequeued blocks until a given Job leaves the queue. It returns immediately if the Job is not queued.
+func (l *Service) waitUntilDequeued(ctx context.Context, id string) error {
+	_, item := l.lookup(id)
+	if item == nil {
+		return nil
+	}
+	select {
+	case <-item.dequeued:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (l *Service) Get(_ context.Context, in GetInput) (*GetOutput, error) {
@@ -102,20 +625,129 @@ func (l *Service) Get(_ context.Context, in GetInput) (*GetOutput, error) {
 		return nil, errors.Wrap(err, "while fetching Job from storage")
 	}
 
+	var queuePosition int
+	if Status(out.Job.Status) == Queued {
+		queuePosition = l.admission.positions()[in.ID]
+	}
+
 	return &GetOutput{
-		CreatedBy: out.Job.Tenant,
-		Status:    Status(out.Job.Status),
-		ExitCode:  out.Job.ExitCode,
+		ID:                out.Job.ID,
+		Name:              out.Job.Name,
+		CreatedBy:         out.Job.Tenant,
+		PID:               out.Job.PID,
+		Status:            Status(out.Job.Status),
+		ExitCode:          out.Job.ExitCode,
+		CreatedAt:         out.Job.CreatedAt,
+		StartedAt:         out.Job.StartedAt,
+		FinishedAt:        out.Job.FinishedAt,
+		TerminationReason: TerminationReason(out.Job.TerminationReason),
+		Signal:            out.Job.Signal,
+		StoppedBy:         out.Job.StoppedBy,
+		Attempt:           len(out.Job.Attempts),
+		Restarts:          restartsCount(out.Job.Attempts),
+		Attempts:          mapAttempts(out.Job.Attempts),
+		QueuePosition:     queuePosition,
+		SecurityProfile:   out.Job.SecurityProfile,
+		Error:             out.Job.Error,
+	}, nil
+}
+
+func restartsCount(attempts []repo.Attempt) int {
+	if len(attempts) == 0 {
+		return 0
+	}
+	return len(attempts) - 1
+}
+
+func mapAttempts(in []repo.Attempt) []Attempt {
+	out := make([]Attempt, 0, len(in))
+	for _, attempt := range in {
+		out = append(out, Attempt(attempt))
+	}
+	return out
+}
+
+// List returns Jobs that match given constraints.
+func (l *Service) List(_ context.Context, in ListInput) (*ListOutput, error) {
+	after, err := decodePageToken(in.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]string, 0, len(in.Statuses))
+	for _, status := range in.Statuses {
+		statuses = append(statuses, string(status))
+	}
+
+	out, err := l.jobStorage.List(repo.ListInput{
+		Tenant:     in.Tenant,
+		Statuses:   statuses,
+		NamePrefix: in.NamePrefix,
+		After:      after,
+		Limit:      in.PageSize,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Jobs from storage")
+	}
+
+	var queuePositions map[string]int
+	items := make([]ListItem, 0, len(out.Jobs))
+	for _, job := range out.Jobs {
+		if job.Status == string(Queued) && queuePositions == nil {
+			queuePositions = l.admission.positions()
+		}
+
+		items = append(items, ListItem{
+			ID:                job.ID,
+			Name:              job.Name,
+			CreatedBy:         job.Tenant,
+			Status:            Status(job.Status),
+			ExitCode:          job.ExitCode,
+			CreatedAt:         job.CreatedAt,
+			StartedAt:         job.StartedAt,
+			FinishedAt:        job.FinishedAt,
+			TerminationReason: TerminationReason(job.TerminationReason),
+			Signal:            job.Signal,
+			StoppedBy:         job.StoppedBy,
+			Restarts:          restartsCount(job.Attempts),
+			QueuePosition:     queuePositions[job.ID],
+		})
+	}
+
+	return &ListOutput{
+		Jobs:          items,
+		NextPageToken: encodePageToken(out.Continue),
 	}, nil
 }
 
 func (l *Service) StreamLogs(ctx context.Context, in StreamLogsInput) (*StreamLogsOutput, error) {
-	out, err := l.jobStorage.Get(repo.GetInput(in))
+	out, err := l.jobStorage.Get(repo.GetInput{ID: in.ID})
 	if err != nil {
 		return nil, errors.Wrap(err, "while fetching Job from storage")
 	}
 
-	outChan, errChan, err := l.fileLogger.ReadAndFollow(ctx, out.Job.Name)
+	if Status(out.Job.Status) == Queued && in.Follow {
+		if err := l.waitUntilDequeued(ctx, in.ID); err != nil {
+			return nil, err
+		}
+		out, err = l.jobStorage.Get(repo.GetInput{ID: in.ID})
+		if err != nil {
+			return nil, errors.Wrap(err, "while fetching Job from storage")
+		}
+	}
+	if out.Job.PID == 0 { // Job was never started, so there are no logs
+		output := make(chan file.Chunk)
+		close(output)
+		return &StreamLogsOutput{Output: output, Error: make(chan error)}, nil
+	}
+
+	outChan, errChan, err := l.fileLogger.ReadAndFollow(ctx, out.Job.ID, file.ReadOptions{
+		Streams:     in.Streams,
+		Follow:      in.Follow,
+		TailLines:   in.TailLines,
+		SinceOffset: in.SinceOffset,
+		LimitBytes:  in.LimitBytes,
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "while reading Job's logs")
 	}
@@ -125,85 +757,637 @@ func (l *Service) StreamLogs(ctx context.Context, in StreamLogsInput) (*StreamLo
 	}, nil
 }
 
+// Attach returns the output produced by a given Job from now on, and allows interacting with its stdin and
+// pseudo-terminal. Queued Job is attached once it's started.
+func (l *Service) Attach(ctx context.Context, in AttachInput) (*AttachOutput, error) {
+	if err := l.waitUntilDequeued(ctx, in.ID); err != nil {
+		return nil, err
+	}
+
+	out, err := l.jobStorage.Get(repo.GetInput{ID: in.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching Job from storage")
+	}
+
+	proc, found := l.getProcess(in.ID)
+	if status := Status(out.Job.Status); status.IsFinished() || !found {
+		return nil, NewNotRunningError(in.ID, status)
+	}
+
+	outChan, errChan, err := l.fileLogger.ReadAndFollow(ctx, in.ID, file.ReadOptions{Follow: true, FromEnd: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading Job's output")
+	}
+	return &AttachOutput{
+		TTY:    proc.in.TTY,
+		Stdin:  proc.stdio.stdinForwarder(),
+		Resize: proc.stdio.resize,
+		Output: outChan,
+		Error:  errChan,
+	}, nil
+}
+
+// GetStats returns resources usage of a given Job read from its cgroup.
+func (l *Service) GetStats(_ context.Context, in GetStatsInput) (*GetStatsOutput, error) {
+	out, err := l.jobStorage.Get(repo.GetInput{ID: in.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching Job from storage")
+	}
+
+	if !l.cgroupEnabled {
+		return nil, NewStatsNotAvailableError(in.ID, "Jobs are not executed in dedicated cgroups")
+	}
+	if Status(out.Job.Status) == Queued {
+		return nil, NewStatsNotAvailableError(in.ID, "Job is queued")
+	}
+
+	// cgroup of a finished Job is removed, only stats read before removal are available
+	if Status(out.Job.Status).IsFinished() {
+		if out.Job.FinalStats == nil {
+			return nil, NewStatsNotAvailableError(in.ID, "final stats were not recorded")
+		}
+		return &GetStatsOutput{
+			Status: Status(out.Job.Status),
+			Stats:  *out.Job.FinalStats,
+		}, nil
+	}
+
+	stats, err := cgroup.Stats(getJobCgroupPath(in.ID))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, NewStatsNotAvailableError(in.ID, "Job's cgroup doesn't exist")
+		}
+		return nil, errors.Wrap(err, "while reading Job's cgroup stats")
+	}
+
+	return &GetStatsOutput{
+		Status: Status(out.Job.Status),
+		Stats:  stats,
+	}, nil
+}
+
 // Stop stops a given Job.
 // TODO(simplification): handle input context cancellation.
 func (l *Service) Stop(_ context.Context, in StopInput) (*StopOutput, error) {
 	// TODO(simplification):
-	// Currently `Stop` is locked for all Jobs. It can be changed to named mutex
+	// Currently stop requests are serialized for all Jobs. It can be changed to named mutex
 	// or we can get rid of mutex usage and think about sync.Once{}
+	// The mutex is not held while the Job terminates, as it may take the whole grace period, or longer.
 	l.stopMux.Lock()
-	defer l.stopMux.Unlock()
+	proc, output, err := l.requestStop(in)
+	l.stopMux.Unlock()
+	if err != nil || output != nil {
+		return output, err
+	}
+
+	stopSignal := in.Signal
+	if stopSignal == 0 {
+		stopSignal = syscall.SIGTERM
+	}
+	l.terminate(proc, stopSignal, in.GracePeriod)
+
+	// final status, together with termination details, is stored once terminate returns
+	return l.stopOutputFromStorage(in.ID)
+}
 
-	out, err := l.jobStorage.Get(repo.GetInput{Name: in.Name})
+// requestStop records stop request for a given Job. If the Job doesn't need to be terminated, e.g. it's already
+// finished or it was canceled while queued, returns its final status instead of the process.
+func (l *Service) requestStop(in StopInput) (*process, *StopOutput, error) {
+	out, err := l.jobStorage.Get(repo.GetInput{ID: in.ID})
 	if err != nil {
-		return nil, errors.Wrap(err, "while getting out definition")
+		return nil, nil, errors.Wrap(err, "while getting out definition")
 	}
 	status := Status(out.Job.Status)
 
 	if status.IsFinished() {
-		return &StopOutput{
-			Status:   status,
-			ExitCode: out.Job.ExitCode,
-		}, nil
+		return nil, stopOutputFromJob(out.Job), nil
 	}
 
-	_ = out.Job.Cmd.Process.Signal(syscall.SIGTERM)
-	if in.GracePeriod != 0 {
-		scheduleHardKill := time.AfterFunc(in.GracePeriod, func() {
-			_ = out.Job.Cmd.Process.Kill() // err is handled by statusForCmd
-		})
-		defer scheduleHardKill.Stop() // cancel hard kill if out.Job.Cmd.Wait() finished before grace period
+	if status == Queued {
+		canceled, err := l.cancelQueued(in.ID, StopRequested, in.StoppedBy)
+		if err != nil {
+			return nil, nil, err
+		}
+		if canceled {
+			output, err := l.stopOutputFromStorage(in.ID)
+			return nil, output, err
+		}
+		// Job was dequeued in the meantime, so it's tracked as running once it's started
+		if _, item := l.lookup(in.ID); item != nil {
+			<-item.dequeued
+		}
 	}
 
-	<-out.Job.RunFinished
+	proc, found := l.getProcess(in.ID)
+	if !found { // process may just finish, re-check stored status
+		output, err := l.stopOutputFromStorage(in.ID)
+		return nil, output, err
+	}
 
-	status, exitCode := l.statusForCmd(out.Job.Cmd)
-	return &StopOutput{
-		Status:   status,
-		ExitCode: exitCode,
+	proc.requestStop(StopRequested, in.StoppedBy)
+	return proc, nil, nil
+}
+
+// Signal sends a given signal to all processes of a running Job. It doesn't change the Job's status,
+// but the Job may exit as a result of the signal.
+func (l *Service) Signal(_ context.Context, in SignalInput) (*SignalOutput, error) {
+	out, err := l.jobStorage.Get(repo.GetInput{ID: in.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching Job from storage")
+	}
+
+	proc, found := l.getProcess(in.ID)
+	if status := Status(out.Job.Status); status.IsFinished() || !found {
+		return nil, NewNotRunningError(in.ID, status)
+	}
+
+	proc.signal(in.Signal)
+	return &SignalOutput{}, nil
+}
+
+// Wait blocks until a given Job finishes and returns its final status. It returns immediately if the Job is already finished.
+// Once it returns, the whole Job's output is available in logs.
+func (l *Service) Wait(ctx context.Context, in WaitInput) (*WaitOutput, error) {
+	if err := l.waitUntilDequeued(ctx, in.ID); err != nil {
+		return nil, err
+	}
+
+	if proc, _ := l.lookup(in.ID); proc != nil {
+		select {
+		case <-proc.runFinished:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	out, err := l.jobStorage.Get(repo.GetInput{ID: in.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching Job from storage")
+	}
+
+	status := Status(out.Job.Status)
+	if !status.IsFinished() {
+		return nil, errors.Newf("internal error: process for running Job %q not found", in.ID)
+	}
+	return &WaitOutput{
+		Status:            status,
+		ExitCode:          out.Job.ExitCode,
+		TerminationReason: TerminationReason(out.Job.TerminationReason),
 	}, nil
 }
 
+// Delete removes a given finished Job together with its logs and cgroup, so its name can be reused.
+// Processes of lost Job may still run, so it's deleted only if they all exited, or the deletion is forced.
+func (l *Service) Delete(_ context.Context, in DeleteInput) (*DeleteOutput, error) {
+	out, err := l.jobStorage.Get(repo.GetInput{ID: in.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching Job from storage")
+	}
+
+	status := Status(out.Job.Status)
+	if proc, found := l.getProcess(in.ID); (found && !proc.finished()) || !status.IsFinished() {
+		return nil, NewNotFinishedError(in.ID, status)
+	}
+
+	if l.cgroupEnabled {
+		// cgroup is removed once Job finishes, but it's left behind if Job was lost on Agent restart
+		cgroupPath := getJobCgroupPath(in.ID)
+		if status == Lost && !in.Force {
+			empty, err := cgroup.IsEmpty(cgroupPath)
+			if err != nil {
+				return nil, errors.Wrap(err, "while checking processes of lost Job")
+			}
+			if !empty {
+				return nil, NewLostProcessesRunningError(in.ID)
+			}
+		}
+		// processes left in the cgroup are killed
+		if err := cgroup.Remove(cgroupPath); err != nil {
+			return nil, errors.Wrap(err, "while removing cgroup")
+		}
+	}
+	if err := l.fileLogger.Remove(in.ID); err != nil {
+		return nil, errors.Wrap(err, "while removing logs")
+	}
+	// record is removed as the last one, so the name cannot be reused until all resources are removed
+	if err := l.jobStorage.Delete(repo.DeleteInput{ID: in.ID}); err != nil {
+		return nil, errors.Wrap(err, "while deleting Job from storage")
+	}
+
+	return &DeleteOutput{}, nil
+}
+
+func (l *Service) stopOutputFromStorage(id string) (*StopOutput, error) {
+	out, err := l.jobStorage.Get(repo.GetInput{ID: id})
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting out definition")
+	}
+
+	status := Status(out.Job.Status)
+	if !status.IsFinished() {
+		return nil, errors.Newf("internal error: process for running Job %q not found", id)
+	}
+	return stopOutputFromJob(out.Job), nil
+}
+
+func stopOutputFromJob(job *repo.JobDefinition) *StopOutput {
+	return &StopOutput{
+		Status:            Status(job.Status),
+		ExitCode:          job.ExitCode,
+		TerminationReason: TerminationReason(job.TerminationReason),
+		Signal:            job.Signal,
+	}
+}
+
+// Shutdown stops all running Jobs started by this Service. Each Job has the configured shutdown grace period
+// to terminate gracefully. It returns when all Jobs exited and their final statuses were stored.
+// Once called, new Jobs cannot be started.
 func (l *Service) Shutdown() error {
-	// TODO: Here we should list all running Jobs and trigger `Stop` for them.
+	atomic.StoreInt32(&l.shuttingDown, 1)
+
+	for _, timer := range l.orphanDeadlines {
+		timer.Stop()
+	}
+
+	l.cancelAllQueued()
+
+	var wg sync.WaitGroup
+	l.processes.Range(func(_, value interface{}) bool {
+		wg.Add(1)
+		go func(proc *process) {
+			defer wg.Done()
+			proc.requestStop(AgentShutdown, "")
+			l.terminate(proc, syscall.SIGTERM, l.shutdownGracePeriod)
+		}(value.(*process))
+		return true
+	})
+	wg.Wait()
+
 	return nil
 }
 
-func (l *Service) watchRunningProcess(job *repo.JobDefinition, release func() error) {
-	defer func() { // release file used for logs (stdout, stderr)
+// cancelAllQueued marks all queued Jobs as terminated, so they are never started. It waits for Jobs being
+// started at the moment, so their processes are tracked once it returns.
+func (l *Service) cancelAllQueued() {
+	l.admission.mu.Lock()
+	queue := append([]*queuedCmd(nil), l.admission.queue...)
+	starting := make([]*queuedCmd, 0, len(l.admission.starting))
+	for _, item := range l.admission.starting {
+		starting = append(starting, item)
+	}
+	l.admission.mu.Unlock()
+
+	for _, item := range queue {
+		// TODO(simplification): handle error, e.g. log it (zap/logrus)
+		_, _ = l.cancelQueued(item.in.id, AgentShutdown, "")
+	}
+	for _, item := range starting {
+		<-item.dequeued
+	}
+}
+
+// terminate sends a given signal to all processes of a given Job, and kills them if they are still running after grace period.
+// Zero grace period means that processes are never killed. It blocks until all processes exited and the Job's status was stored.
+func (l *Service) terminate(proc *process, sig syscall.Signal, gracePeriod time.Duration) {
+	proc.signal(sig)
+	if gracePeriod != 0 {
+		scheduleHardKill := time.AfterFunc(gracePeriod, func() {
+			proc.signal(syscall.SIGKILL) // err is handled by statusForCmd
+		})
+		defer scheduleHardKill.Stop() // cancel hard kill if all processes exited before grace period
+	}
+
+	<-proc.runFinished
+}
+
+// signal sends a given signal to all processes in the Job's cgroup. If cgroup is not available, only the Job's main process is signaled.
+// For Job running in new namespaces, the main process is the init which forwards signals to all processes in the namespace,
+// so only signals which cannot be caught are sent to the cgroup.
+func (p *process) signal(sig syscall.Signal) {
+	if p.in.Isolation.Namespaced() && sig != syscall.SIGKILL && sig != syscall.SIGSTOP {
+		_ = p.currentCmd().Process.Signal(sig)
+		return
+	}
+	if p.cgroupPath != "" {
+		var err error
+		if sig == syscall.SIGKILL {
+			err = cgroup.Kill(p.cgroupPath)
+		} else {
+			err = cgroup.Signal(p.cgroupPath, sig)
+		}
+		if err == nil {
+			return
+		}
+	}
+	_ = p.currentCmd().Process.Signal(sig)
+}
+
+// waitUntilExited blocks until there are no processes left in the Job's cgroup.
+func (p *process) waitUntilExited() {
+	if p.cgroupPath == "" {
+		return
+	}
+	for {
+		empty, err := cgroup.IsEmpty(p.cgroupPath)
+		if err != nil || empty {
+			return
+		}
+		time.Sleep(exitPollInterval)
+	}
+}
+
+func (l *Service) watchRunningProcess(id string, proc *process, release file.ReleaseSinkFn) {
+	defer func() {
+		if proc.deadline != nil {
+			proc.deadline.Stop()
+		}
+		// release stdin, pseudo-terminal and file used for logs (stdout, stderr)
 		// TODO(simplification): handle error gracefully
 		_ = release()
+		// closed once resources are released, so Wait returns only after the whole output is flushed to logs,
+		// and the finished Job can be deleted right away
+		close(proc.runFinished)
+		// removed as the last one, so the process is found until its resources are released
+		l.processes.Delete(id)
+		l.releaseAdmission(proc.in.Tenant)
 	}()
 
-	_ = job.Cmd.Wait()
-	status, exitCode := l.statusForCmd(job.Cmd)
+	for {
+		cmd := proc.currentCmd()
+		_ = cmd.wait()
+		reason, stoppedBy := proc.stopRequest()
+		if reason != "" {
+			// Stop is delivered to all Job's processes, so the Job is stopped only when all of them exited.
+			proc.waitUntilExited()
+		}
+		finishedAt := time.Now()
+		status, exitCode := l.statusForCmd(cmd)
+		signal := signalForCmd(cmd)
+
+		if reason == "" && proc.in.Restart.shouldRestart(status, proc.restarts) {
+			finished := repo.Attempt{
+				StartedAt:  proc.attemptStartedAt,
+				FinishedAt: finishedAt,
+				ExitCode:   exitCode,
+				Signal:     signal,
+			}
+			if l.restart(id, proc, finished) {
+				continue
+			}
+			// restart could be interrupted by stop request
+			reason, stoppedBy = proc.stopRequest()
+		}
+
+		finalStats := l.cleanupCgroup(id)
+
+		if reason == "" && status != Succeeded && finalStats != nil && finalStats.Memory.Events.OOMKill > proc.oomKills {
+			// The OOM killer sends SIGKILL, so otherwise such Job couldn't be distinguished from other terminated ones.
+			status, reason = Terminated, OOMKilled
+		}
+
+		// TODO(simplification): handle error:
+		//  - log it (zap/logrus)
+		//  - execute retry. If after X retries we still get an error, push it to a dead letter queue.
+		_ = l.jobStorage.Update(repo.UpdateInput{
+			ID:                id,
+			Status:            string(status),
+			ExitCode:          exitCode,
+			FinishedAt:        finishedAt,
+			TerminationReason: string(reason),
+			Signal:            signal,
+			StoppedBy:         stoppedBy,
+			FinalStats:        finalStats,
+		})
+		return
+	}
+}
+
+// restart starts the next attempt of a given Job after backoff. The attempt is started in the same cgroup
+// and it uses the same standard streams. Returns false if the Job was stopped in the meantime, or the
+// next attempt couldn't be started.
+func (l *Service) restart(id string, proc *process, finished repo.Attempt) bool {
+	select {
+	case <-time.After(proc.in.Restart.backoff(proc.restarts)):
+	case <-proc.stopRequested:
+		return false
+	}
+
+	if proc.cgroupPath != "" {
+		// processes that outlived the previous attempt could affect the next one
+		_ = cgroup.Kill(proc.cgroupPath)
+		proc.waitUntilExited()
+		if stats, err := cgroup.Stats(proc.cgroupPath); err == nil {
+			proc.oomKills = stats.Memory.Events.OOMKill
+		}
+	}
+
+	// Stop request cannot be handled while the next attempt is being started, otherwise it could miss the new process.
+	proc.mu.Lock()
+	defer proc.mu.Unlock()
+	if proc.stopReason != "" {
+		return false
+	}
+
+	attempt := proc.restarts + 2
+	// TODO(simplification): handle error, e.g. log it (zap/logrus)
+	_, _ = fmt.Fprintf(proc.stdio.sink.Stderr, "[agent] restarting Job, attempt %d, previous attempt exited with code %d\n", attempt, finished.ExitCode)
+
+	cmd, err := l.startProc(proc.in, proc.stdio)
+	if err != nil {
+		_, _ = fmt.Fprintf(proc.stdio.sink.Stderr, "[agent] cannot start attempt %d: %v\n", attempt, err)
+		return false
+	}
+
+	proc.cmd = cmd
+	proc.restarts++
+	proc.attemptStartedAt = time.Now()
+	// TODO(simplification): handle error, e.g. log it (zap/logrus)
+	_ = l.jobStorage.Restart(repo.RestartInput{
+		ID:        id,
+		PID:       cmd.Process.Pid,
+		StartedAt: proc.attemptStartedAt,
+		Finished:  finished,
+	})
+	return true
+}
+
+// cleanupCgroup reads final stats of the Job's cgroup and removes it together with processes that outlived
+// the Job's main process. Returns nil stats if they cannot be read.
+func (l *Service) cleanupCgroup(id string) *cgroup.GroupStats {
+	if !l.cgroupEnabled {
+		return nil
+	}
+
+	path := getJobCgroupPath(id)
+	stats, err := cgroup.Stats(path)
+	// TODO(simplification): handle errors gracefully, e.g. log them (zap/logrus) and retry cgroup removal.
+	_ = cgroup.Remove(path)
+	if err != nil {
+		return nil
+	}
+	return &stats
+}
+
+func (l *Service) getProcess(id string) (*process, bool) {
+	proc, found := l.processes.Load(id)
+	if !found {
+		return nil, false
+	}
+	return proc.(*process), true
+}
+
+// markOrphanedJobs marks Jobs stored as running as lost. It's called before any Job is started by a given Service,
+// so such Jobs were started by previous Agent instance and their exit status cannot be collected.
+// Their deadlines, if any, are still enforced. Jobs stored as queued are marked as terminated, as they were never started.
+func (l *Service) markOrphanedJobs() error {
+	out, err := l.jobStorage.List(repo.ListInput{Statuses: []string{string(Running), string(Lost), string(Queued)}})
+	if err != nil {
+		return err
+	}
+
+	for _, job := range out.Jobs {
+		if job.Status == string(Queued) {
+			// Queued Jobs are kept only in memory, so they cannot be started anymore.
+			err := l.jobStorage.Update(repo.UpdateInput{
+				ID:                job.ID,
+				Status:            string(Terminated),
+				FinishedAt:        time.Now(),
+				TerminationReason: string(AgentShutdown),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "while updating Job %q", job.ID)
+			}
+			continue
+		}
+
+		// Processes of lost Jobs may still run, so their deadlines are enforced until it's done once.
+		if !job.Deadline.IsZero() && job.TerminationReason == "" {
+			l.enforceOrphanedDeadline(*job)
+		}
+		if job.Status != string(Running) {
+			continue
+		}
+
+		err := l.jobStorage.Update(repo.UpdateInput{
+			ID:                job.ID,
+			Status:            string(Lost),
+			ExitCode:          job.ExitCode,
+			FinishedAt:        job.FinishedAt,
+			TerminationReason: job.TerminationReason,
+			Signal:            job.Signal,
+			StoppedBy:         job.StoppedBy,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "while updating Job %q", job.ID)
+		}
+	}
+	return nil
+}
+
+// enforceOrphanedDeadline stops processes of a Job started by previous Agent instance once its deadline is exceeded.
+// Such Job's process cannot be waited for, so all processes in its cgroup are stopped and the cgroup is removed.
+func (l *Service) enforceOrphanedDeadline(job repo.JobDefinition) {
+	if !l.cgroupEnabled {
+		return
+	}
+
+	path := getJobCgroupPath(job.ID)
+	timer := time.AfterFunc(time.Until(job.Deadline), func() {
+		if empty, err := cgroup.IsEmpty(path); err != nil || empty {
+			_ = cgroup.Remove(path)
+			return
+		}
+
+		_ = cgroup.Signal(path, syscall.SIGTERM)
+		killAt := time.Now().Add(job.DeadlineGracePeriod)
+		for job.DeadlineGracePeriod == 0 || time.Now().Before(killAt) {
+			if atomic.LoadInt32(&l.shuttingDown) == 1 {
+				return // processes already got SIGTERM, the next Agent instance enforces the deadline again
+			}
+			if empty, err := cgroup.IsEmpty(path); err != nil || empty {
+				break
+			}
+			time.Sleep(exitPollInterval)
+		}
+		_ = cgroup.Remove(path)
 
-	// TODO(simplification): handle error:
-	//  - log it (zap/logrus)
-	//  - execute retry. If after X retries we still get an error, push it to a dead letter queue.
-	_ = l.jobStorage.Update(repo.UpdateInput{
-		Name:     job.Name,
-		Status:   string(status),
-		ExitCode: exitCode,
+		// TODO(simplification): handle error, e.g. log it (zap/logrus)
+		_ = l.jobStorage.Update(repo.UpdateInput{
+			ID:                job.ID,
+			Status:            string(Lost),
+			ExitCode:          job.ExitCode,
+			FinishedAt:        time.Now(),
+			TerminationReason: string(DeadlineExceeded),
+		})
 	})
+	l.orphanDeadlines = append(l.orphanDeadlines, timer)
 }
 
-// statusForCmd can be called only if `Wait` was already executed for a given cmd.
-func (l *Service) statusForCmd(cmd *exec.Cmd) (Status, int) {
-	if cmd.ProcessState.Success() {
+// statusForCmd can be called only if `wait` was already executed for a given cmd.
+func (l *Service) statusForCmd(cmd *jobCmd) (Status, int) {
+	if _, signaled := terminatingSignal(cmd); signaled {
+		return Terminated, -1
+	}
+
+	exitCode := cmd.ProcessState.ExitCode()
+	if status, ok := cmd.waitStatus(); ok {
+		exitCode = status.ExitStatus()
+	}
+	if exitCode == 0 {
 		return Succeeded, 0
 	}
+	return Failed, exitCode
+}
+
+// signalForCmd returns the name of the signal that killed the process, or empty string if it exited on its own.
+// It can be called only if `wait` was already executed for a given cmd.
+func signalForCmd(cmd *jobCmd) string {
+	sig, signaled := terminatingSignal(cmd)
+	if !signaled {
+		return ""
+	}
+	return unix.SignalName(sig)
+}
+
+// startInJobCgroup starts the Job's process directly in a dedicated cgroup. If it's not supported, the process
+// is started via the child wrapper, which attaches itself to the cgroup and then executes the command in place.
+// In both cases, the started process is the Job's process, so its PID is the real one. The only exception is Job running
+// in new namespaces, which process is the child wrapper running as init of the new PID namespace.
+func startInJobCgroup(in RunInput, stdio *stdio) (*jobCmd, error) {
+	cgroupPath := getJobCgroupPath(in.id)
+	if err := cgroup.BootstrapChild(cgroupPath, *in.Resources); err != nil {
+		return nil, err
+	}
+
+	// umask cannot be set via SysProcAttr, new namespaces need private /proc and loopback interface,
+	// and security profile is applied right before exec, so only the child wrapper can apply them
+	if in.Umask == "" && !in.Isolation.Namespaced() && in.securityProfile == nil {
+		cmd := directProcCmd(in, stdio)
+		err := startIntoCgroup(cmd, cgroupPath)
+		switch {
+		case err == nil:
+			return &jobCmd{Cmd: cmd}, nil
+		case !isCloneIntoCgroupNotSupported(err):
+			return nil, err
+		}
+	}
 
-	sysStatus := cmd.ProcessState.Sys().(syscall.WaitStatus)
-	if sysStatus.Signaled() {
-		return Terminated, cmd.ProcessState.ExitCode()
+	cmd, err := wrapProcForChildExecution(in, stdio)
+	if err != nil {
+		return nil, errors.Wrap(err, "while wrapping for child proc execution")
 	}
+	return cmd, cmd.start()
+}
 
-	return Failed, cmd.ProcessState.ExitCode()
+// isCloneIntoCgroupNotSupported returns true if the process couldn't be cloned into cgroup,
+// as kernel doesn't support it. CLONE_INTO_CGROUP is available since Linux 5.7.
+func isCloneIntoCgroupNotSupported(err error) bool {
+	return errors.Is(err, errCloneIntoCgroupNotSupported) ||
+		errors.Is(err, syscall.ENOSYS) || errors.Is(err, syscall.E2BIG) || errors.Is(err, syscall.EINVAL)
 }
 
-func wrapProcForChildExecution(in RunInput, sink io.Writer) (*exec.Cmd, error) {
-	cgroupPath := getJobCgroupPath(in.Name)
+func wrapProcForChildExecution(in RunInput, stdio *stdio) (*jobCmd, error) {
+	cgroupPath := getJobCgroupPath(in.id)
 
 	selfBin, err := os.Executable()
 	if err != nil {
@@ -215,32 +1399,100 @@ func wrapProcForChildExecution(in RunInput, sink io.Writer) (*exec.Cmd, error) {
 		childArgs = append(childArgs, "--env", env)
 	}
 	childArgs = append(childArgs, "--cgroup-procs-path", cgroupPath)
+	if in.WorkingDir != "" {
+		childArgs = append(childArgs, "--working-dir", in.WorkingDir)
+	}
+	if in.Umask != "" {
+		childArgs = append(childArgs, "--umask", in.Umask)
+	}
+	if in.Isolation.Namespaced() {
+		childArgs = append(childArgs, "--isolation", string(in.Isolation))
+	}
+	if in.rootfsPath != "" {
+		childArgs = append(childArgs, "--rootfs", in.rootfsPath)
+		for _, path := range in.ReadOnlyPaths {
+			childArgs = append(childArgs, "--read-only-path", path)
+		}
+	}
+	if in.securityProfile != nil {
+		profile, err := json.Marshal(in.securityProfile)
+		if err != nil {
+			return nil, errors.Wrap(err, "while encoding security profile")
+		}
+		childArgs = append(childArgs, "--security-profile", string(profile))
+	}
+	if cred := in.credential; cred != nil {
+		// the wrapper must run as root to attach itself to the cgroup, so it drops privileges on its own
+		childArgs = append(childArgs, "--uid", strconv.FormatUint(uint64(cred.Uid), 10), "--gid", strconv.FormatUint(uint64(cred.Gid), 10))
+		for _, gid := range cred.Groups {
+			childArgs = append(childArgs, "--groups", strconv.FormatUint(uint64(gid), 10))
+		}
+	}
+	if in.Isolation.Namespaced() {
+		childArgs = append(childArgs, "--init-status-fd", strconv.Itoa(initStatusFD))
+	}
 	childArgs = append(childArgs, "--", in.Command)
 	childArgs = append(childArgs, in.Args...)
 
 	cmd := exec.Command(selfBin, childArgs...)
-	cmd.Stderr = sink
-	cmd.Stdout = sink
+	// the Job's command is executed by the child wrapper, so it inherits standard streams and namespaces
+	stdio.apply(cmd)
+	if flags := in.Isolation.cloneflags(); flags != 0 {
+		if cmd.SysProcAttr == nil {
+			cmd.SysProcAttr = &syscall.SysProcAttr{}
+		}
+		cmd.SysProcAttr.Cloneflags = flags
+	}
+	if !in.Isolation.Namespaced() {
+		return &jobCmd{Cmd: cmd}, nil
+	}
 
-	err = cgroup.BootstrapChild(cgroupPath, DefaultProcResources)
+	// the write end is passed as the first extra file, so it's available under initStatusFD
+	statusReader, statusWriter, err := os.Pipe()
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "while creating init status pipe")
 	}
-	return cmd, nil
+	cmd.ExtraFiles = []*os.File{statusWriter}
+	return &jobCmd{Cmd: cmd, initStatus: statusReader}, nil
+}
+
+func getJobCgroupPath(id string) string {
+	return filepath.Join(cgroup.PseudoFsPrefix, cgroupDefaultParentName, id)
 }
 
-func getJobCgroupPath(name string) string {
-	return filepath.Join(cgroup.PseudoFsPrefix, cgroupDefaultParentName, name)
+// startDirectly starts the Job's process in the Agent's cgroup.
+func startDirectly(in RunInput, stdio *stdio) (*jobCmd, error) {
+	cmd := &jobCmd{Cmd: directProcCmd(in, stdio)}
+	return cmd, cmd.start()
 }
 
-func directProcExecution(in RunInput, sink io.Writer) (*exec.Cmd, error) {
+func directProcCmd(in RunInput, stdio *stdio) *exec.Cmd {
 	// This needs to be allowed, but we need to be aware of potential risk:
 	//   https://github.com/securego/gosec/issues/204#issuecomment-384474356
 	// #nosec G204
 	cmd := exec.Command(in.Command, in.Args...)
 	cmd.Env = in.Env
-	cmd.Stderr = sink
-	cmd.Stdout = sink
+	cmd.Dir = in.WorkingDir
+	if in.credential != nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{Credential: in.credential}
+	}
+	stdio.apply(cmd)
+
+	return cmd
+}
+
+// encodePageToken returns an opaque page token, so clients don't depend on the pagination implementation.
+func encodePageToken(after string) string {
+	if after == "" {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(after))
+}
 
-	return cmd, nil
+func decodePageToken(token string) (string, error) {
+	after, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", NewInvalidInputError("invalid page token")
+	}
+	return string(after), nil
 }
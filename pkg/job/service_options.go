@@ -1,5 +1,11 @@
 package job
 
+import (
+	"time"
+
+	"github.com/mszostok/job-runner/pkg/security"
+)
+
 // ServiceOption provides an option to configure Service instance.
 type ServiceOption func(cfg *Service)
 
@@ -8,6 +14,57 @@ type ServiceOption func(cfg *Service)
 // - and execution via child process.
 func WithoutCgroup() ServiceOption {
 	return func(cfg *Service) {
-		cfg.createProcCmd = directProcExecution
+		cfg.startProc = startDirectly
+		cfg.cgroupEnabled = false
+	}
+}
+
+// WithResourcesLimits sets the maximum resources' limits that can be requested for a single Job.
+func WithResourcesLimits(limits ResourcesLimits) ServiceOption {
+	return func(cfg *Service) {
+		cfg.resourcesLimits = limits
+	}
+}
+
+// WithShutdownGracePeriod sets a period of time given to running Jobs to terminate gracefully on Service shutdown.
+// Zero means that Jobs are never killed.
+func WithShutdownGracePeriod(gracePeriod time.Duration) ServiceOption {
+	return func(cfg *Service) {
+		cfg.shutdownGracePeriod = gracePeriod
+	}
+}
+
+// WithAdmissionLimits sets how many Jobs can run concurrently. Jobs requested above the limits are queued.
+func WithAdmissionLimits(limits AdmissionLimits) ServiceOption {
+	return func(cfg *Service) {
+		cfg.admission = newAdmission(limits)
+	}
+}
+
+// WithIdentityPolicy restricts users and groups Jobs of a given tenant can run as.
+func WithIdentityPolicy(policy *IdentityPolicy) ServiceOption {
+	return func(cfg *Service) {
+		cfg.identityPolicy = policy
+	}
+}
+
+// WithDefaultIsolation sets isolation of Jobs requested without it. By default, Jobs run in the host namespaces.
+func WithDefaultIsolation(isolation Isolation) ServiceOption {
+	return func(cfg *Service) {
+		cfg.defaultIsolation = isolation
+	}
+}
+
+// WithSecurityProfiles enables hardening Jobs with given security profiles. Profiles require dedicated cgroups.
+func WithSecurityProfiles(profiles *security.Profiles) ServiceOption {
+	return func(cfg *Service) {
+		cfg.securityProfiles = profiles
+	}
+}
+
+// WithRootfsProvider enables running Jobs in root filesystems of images.
+func WithRootfsProvider(provider RootfsProvider) ServiceOption {
+	return func(cfg *Service) {
+		cfg.rootfs = provider
 	}
 }
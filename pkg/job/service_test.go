@@ -0,0 +1,962 @@
+package job_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mszostok/job-runner/pkg/file"
+	"github.com/mszostok/job-runner/pkg/job"
+	"github.com/mszostok/job-runner/pkg/job/repo"
+	"github.com/mszostok/job-runner/pkg/security"
+)
+
+func TestServiceShutdown(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup(), job.WithShutdownGracePeriod(100*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	run, err := svc.Run(ctx, job.RunInput{
+		Tenant:  tenant,
+		Name:    "ignores-sigterm",
+		Command: "sh",
+		Args:    []string{"-c", "trap '' TERM; sleep 60"},
+	})
+	require.NoError(t, err)
+
+	// when
+	err = svc.Shutdown()
+
+	// then
+	require.NoError(t, err)
+
+	out, err := svc.Get(ctx, job.GetInput{ID: run.ID})
+	require.NoError(t, err)
+	assert.Equal(t, job.Terminated, out.Status)
+	assert.Equal(t, job.AgentShutdown, out.TerminationReason)
+
+	_, err = svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "after-shutdown", Command: "true"})
+	assert.ErrorIs(t, err, job.ErrShuttingDown)
+}
+
+func TestServiceStopRecordsTerminationDetails(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	run, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "sleeper", Command: "sleep", Args: []string{"60"}})
+	require.NoError(t, err)
+
+	// when
+	stopOut, err := svc.Stop(ctx, job.StopInput{ID: run.ID, GracePeriod: time.Second, StoppedBy: "Ricky"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, job.Terminated, stopOut.Status)
+	assert.Equal(t, job.StopRequested, stopOut.TerminationReason)
+	assert.Equal(t, "SIGTERM", stopOut.Signal)
+
+	out, err := svc.Get(ctx, job.GetInput{ID: run.ID})
+	require.NoError(t, err)
+	assert.Equal(t, job.StopRequested, out.TerminationReason)
+	assert.Equal(t, "SIGTERM", out.Signal)
+	assert.Equal(t, "Ricky", out.StoppedBy)
+	assert.NotZero(t, out.PID)
+}
+
+func TestServiceStopDoesNotBlockOtherStops(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	ready := filepath.Join(t.TempDir(), "ready")
+	stubborn, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "ignores-sigterm", Command: "sh", Args: []string{"-c", "trap '' TERM; touch " + ready + "; sleep 60"}})
+	require.NoError(t, err)
+	sleeper, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "sleeper", Command: "sleep", Args: []string{"60"}})
+	require.NoError(t, err)
+
+	// SIGTERM is ignored only once the trap is set
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(ready)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// zero grace period means that the Job is never killed
+	stubbornStopped := make(chan struct{})
+	go func() {
+		defer close(stubbornStopped)
+		_, _ = svc.Stop(ctx, job.StopInput{ID: stubborn.ID})
+	}()
+	time.Sleep(100 * time.Millisecond) // let the first Stop request stop, and wait for the Job to terminate
+
+	// when
+	stopOut, err := svc.Stop(ctx, job.StopInput{ID: sleeper.ID, GracePeriod: time.Second})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, job.Terminated, stopOut.Status)
+
+	select {
+	case <-stubbornStopped:
+		t.Fatal("Job ignoring SIGTERM shouldn't be stopped")
+	default:
+	}
+
+	killOut, err := svc.Stop(ctx, job.StopInput{ID: stubborn.ID, Signal: syscall.SIGKILL})
+	require.NoError(t, err)
+	assert.Equal(t, "SIGKILL", killOut.Signal)
+	<-stubbornStopped
+}
+
+func TestServiceSignal(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	run, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "sleeper", Command: "sleep", Args: []string{"60"}})
+	require.NoError(t, err)
+
+	// when
+	_, err = svc.Signal(ctx, job.SignalInput{ID: run.ID, Signal: syscall.SIGUSR1})
+
+	// then
+	require.NoError(t, err)
+
+	var out *job.GetOutput
+	require.Eventually(t, func() bool {
+		out, err = svc.Get(ctx, job.GetInput{ID: run.ID})
+		return err == nil && out.Status.IsFinished()
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, job.Terminated, out.Status)
+	assert.Equal(t, "SIGUSR1", out.Signal)
+	assert.Empty(t, out.TerminationReason)
+
+	// when
+	_, err = svc.Signal(ctx, job.SignalInput{ID: run.ID, Signal: syscall.SIGUSR1})
+
+	// then
+	assert.True(t, job.IsFailedPreconditionError(err))
+}
+
+func TestServiceRunTimeout(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// when
+	run, err := svc.Run(ctx, job.RunInput{
+		Tenant:             tenant,
+		Name:               "runaway",
+		Command:            "sleep",
+		Args:               []string{"60"},
+		Timeout:            100 * time.Millisecond,
+		TimeoutGracePeriod: time.Second,
+	})
+
+	// then
+	require.NoError(t, err)
+
+	var out *job.GetOutput
+	require.Eventually(t, func() bool {
+		out, err = svc.Get(ctx, job.GetInput{ID: run.ID})
+		return err == nil && out.Status.IsFinished()
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, job.Terminated, out.Status)
+	assert.Equal(t, job.DeadlineExceeded, out.TerminationReason)
+	assert.Equal(t, "SIGTERM", out.Signal)
+
+	// when
+	_, err = svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "invalid", Command: "true", Timeout: -time.Second})
+
+	// then
+	assert.True(t, job.IsInvalidInputError(err))
+}
+
+func TestServiceRestartPolicy(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// when
+	run, err := svc.Run(ctx, job.RunInput{
+		Tenant:  tenant,
+		Name:    "flaky",
+		Command: "sh",
+		Args:    []string{"-c", "echo run; exit 3"},
+		Restart: job.RestartOptions{
+			Policy:      job.RestartOnFailure,
+			MaxRestarts: 2,
+			Backoff:     10 * time.Millisecond,
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+
+	var out *job.GetOutput
+	require.Eventually(t, func() bool {
+		out, err = svc.Get(ctx, job.GetInput{ID: run.ID})
+		return err == nil && out.Status.IsFinished()
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, job.Failed, out.Status)
+	assert.Equal(t, 3, out.ExitCode)
+	assert.Equal(t, 3, out.Attempt)
+	assert.Equal(t, 2, out.Restarts)
+	require.Len(t, out.Attempts, 3)
+	for _, attempt := range out.Attempts {
+		assert.Equal(t, 3, attempt.ExitCode)
+		assert.False(t, attempt.FinishedAt.IsZero())
+	}
+
+	logs, err := svc.StreamLogs(ctx, job.StreamLogsInput{ID: run.ID})
+	require.NoError(t, err)
+	var stdout, stderr bytes.Buffer
+	require.NoError(t, job.ForwardStreamLogs(ctx, &stdout, &stderr, logs))
+	assert.Equal(t, "run\nrun\nrun\n", stdout.String())
+	assert.Equal(t, "[agent] restarting Job, attempt 2, previous attempt exited with code 3\n"+
+		"[agent] restarting Job, attempt 3, previous attempt exited with code 3\n", stderr.String())
+}
+
+func TestServiceStopInterruptsRestartBackoff(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	run, err := svc.Run(ctx, job.RunInput{
+		Tenant:  tenant,
+		Name:    "always",
+		Command: "true",
+		Restart: job.RestartOptions{Policy: job.RestartAlways, Backoff: time.Hour},
+	})
+	require.NoError(t, err)
+
+	// when
+	stopOut, err := svc.Stop(ctx, job.StopInput{ID: run.ID, GracePeriod: time.Second})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, job.StopRequested, stopOut.TerminationReason)
+
+	out, err := svc.Get(ctx, job.GetInput{ID: run.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, out.Attempt)
+}
+
+func TestServiceWait(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	run, err := svc.Run(ctx, job.RunInput{
+		Tenant:  tenant,
+		Name:    "exits-later",
+		Command: "sh",
+		Args:    []string{"-c", "sleep 0.2; echo done; exit 3"},
+	})
+	require.NoError(t, err)
+
+	// when
+	out, err := svc.Wait(ctx, job.WaitInput{ID: run.ID})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, job.Failed, out.Status)
+	assert.Equal(t, 3, out.ExitCode)
+
+	// whole output is available once Wait returns
+	logs, err := svc.StreamLogs(ctx, job.StreamLogsInput{ID: run.ID})
+	require.NoError(t, err)
+	var stdout, stderr bytes.Buffer
+	require.NoError(t, job.ForwardStreamLogs(ctx, &stdout, &stderr, logs))
+	assert.Equal(t, "done\n", stdout.String())
+
+	// already finished Job is returned immediately
+	out, err = svc.Wait(ctx, job.WaitInput{ID: run.ID})
+	require.NoError(t, err)
+	assert.Equal(t, job.Failed, out.Status)
+}
+
+func TestServiceAdmissionQueue(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup(), job.WithAdmissionLimits(job.AdmissionLimits{
+		MaxRunning:          2,
+		MaxRunningPerTenant: 1,
+		Ordering:            job.PriorityOrdering,
+	}))
+	require.NoError(t, err)
+	defer svc.Shutdown()
+
+	ctx := context.Background()
+	ids := map[string]string{}
+	run := func(tenant, name string, priority int, cmd string) {
+		out, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: name, Command: "sh", Args: []string{"-c", cmd}, Priority: priority})
+		require.NoError(t, err)
+		ids[name] = out.ID
+	}
+	assertStatus := func(name string, expStatus job.Status, expPosition int) {
+		out, err := svc.Get(ctx, job.GetInput{ID: ids[name]})
+		require.NoError(t, err)
+		assert.Equal(t, expStatus, out.Status, name)
+		assert.Equal(t, expPosition, out.QueuePosition, name)
+	}
+
+	// when
+	run("Ricky", "blocker", 0, "sleep 60")
+	run("Ricky", "low-priority", 0, "echo low")
+	run("Ricky", "high-priority", 5, "echo high")
+	run("Ricky", "canceled", 10, "echo canceled")
+	run("Morty", "other-tenant", 0, "echo other")
+
+	// then
+	assertStatus("blocker", job.Running, 0)
+	assertStatus("canceled", job.Queued, 1)
+	assertStatus("high-priority", job.Queued, 2)
+	assertStatus("low-priority", job.Queued, 3)
+
+	// other tenant is not blocked by the queued Jobs
+	out, err := svc.Wait(ctx, job.WaitInput{ID: ids["other-tenant"]})
+	require.NoError(t, err)
+	assert.Equal(t, job.Succeeded, out.Status)
+
+	// when
+	stopped, err := svc.Stop(ctx, job.StopInput{ID: ids["canceled"], StoppedBy: "Ricky"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, job.Terminated, stopped.Status)
+	assert.Equal(t, job.StopRequested, stopped.TerminationReason)
+
+	canceled, err := svc.Get(ctx, job.GetInput{ID: ids["canceled"]})
+	require.NoError(t, err)
+	assert.Zero(t, canceled.PID)
+	assert.Zero(t, canceled.Attempt)
+	assertStatus("high-priority", job.Queued, 1)
+	assertStatus("low-priority", job.Queued, 2)
+
+	// when
+	_, err = svc.Stop(ctx, job.StopInput{ID: ids["blocker"]})
+	require.NoError(t, err)
+
+	// then
+	high, err := svc.Wait(ctx, job.WaitInput{ID: ids["high-priority"]})
+	require.NoError(t, err)
+	assert.Equal(t, job.Succeeded, high.Status)
+	low, err := svc.Wait(ctx, job.WaitInput{ID: ids["low-priority"]})
+	require.NoError(t, err)
+	assert.Equal(t, job.Succeeded, low.Status)
+
+	highOut, err := svc.Get(ctx, job.GetInput{ID: ids["high-priority"]})
+	require.NoError(t, err)
+	lowOut, err := svc.Get(ctx, job.GetInput{ID: ids["low-priority"]})
+	require.NoError(t, err)
+	assert.False(t, lowOut.StartedAt.Before(highOut.FinishedAt), "low priority Job should start after the high priority one finished")
+
+	logs, err := svc.StreamLogs(ctx, job.StreamLogsInput{ID: ids["canceled"]})
+	require.NoError(t, err)
+	var stdout, stderr bytes.Buffer
+	require.NoError(t, job.ForwardStreamLogs(ctx, &stdout, &stderr, logs))
+	assert.Empty(t, stdout.String())
+}
+
+func TestServiceShutdownCancelsQueuedJobs(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup(), job.WithAdmissionLimits(job.AdmissionLimits{MaxRunning: 1}))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "running", Command: "sleep", Args: []string{"60"}})
+	require.NoError(t, err)
+	queued, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "queued", Command: "sleep", Args: []string{"60"}})
+	require.NoError(t, err)
+
+	// when
+	err = svc.Shutdown()
+
+	// then
+	require.NoError(t, err)
+	out, err := svc.Get(ctx, job.GetInput{ID: queued.ID})
+	require.NoError(t, err)
+	assert.Equal(t, job.Terminated, out.Status)
+	assert.Equal(t, job.AgentShutdown, out.TerminationReason)
+	assert.Zero(t, out.PID)
+}
+
+func TestServiceQueuedJobStartFailure(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup(), job.WithAdmissionLimits(job.AdmissionLimits{MaxRunning: 1}))
+	require.NoError(t, err)
+	defer svc.Shutdown()
+
+	ctx := context.Background()
+	blocker, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "blocker", Command: "sleep", Args: []string{"60"}})
+	require.NoError(t, err)
+	queued, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "queued", Command: "/not/existing/command"})
+	require.NoError(t, err)
+
+	// when
+	_, err = svc.Stop(ctx, job.StopInput{ID: blocker.ID})
+	require.NoError(t, err)
+	out, err := svc.Wait(ctx, job.WaitInput{ID: queued.ID})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, job.Failed, out.Status)
+	assert.Equal(t, job.StartFailed, out.TerminationReason)
+
+	got, err := svc.Get(ctx, job.GetInput{ID: queued.ID})
+	require.NoError(t, err)
+	assert.Contains(t, got.Error, "/not/existing/command")
+	assert.False(t, got.FinishedAt.IsZero())
+	assert.Zero(t, got.PID)
+
+	// admission slot is released, so next Job is started right away
+	next, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "next", Command: "true"})
+	require.NoError(t, err)
+	nextOut, err := svc.Wait(ctx, job.WaitInput{ID: next.ID})
+	require.NoError(t, err)
+	assert.Equal(t, job.Succeeded, nextOut.Status)
+}
+
+func TestServiceRunAsUser(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("changing the Job's user requires root privileges")
+	}
+
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	policy := &job.IdentityPolicy{
+		Tenants: map[string]job.IdentityRule{
+			tenant: {DefaultUser: "65534", AllowedUIDs: []uint32{65534}, AllowedGIDs: []uint32{65534}},
+		},
+	}
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup(), job.WithIdentityPolicy(policy))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// when
+	run, err := svc.Run(ctx, job.RunInput{
+		Tenant:     tenant,
+		Name:       "whoami",
+		Command:    "sh",
+		Args:       []string{"-c", "id -u; id -g; id -G; pwd"},
+		Group:      "65534",
+		WorkingDir: "/",
+	})
+	require.NoError(t, err)
+	_, rootErr := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "root", Command: "id", User: "0", Group: "65534"})
+
+	// then
+	assert.True(t, job.IsPermissionDeniedError(rootErr))
+
+	out, err := svc.Wait(ctx, job.WaitInput{ID: run.ID})
+	require.NoError(t, err)
+	assert.Equal(t, job.Succeeded, out.Status)
+
+	logs, err := svc.StreamLogs(ctx, job.StreamLogsInput{ID: run.ID})
+	require.NoError(t, err)
+	var stdout, stderr bytes.Buffer
+	require.NoError(t, job.ForwardStreamLogs(ctx, &stdout, &stderr, logs))
+	assert.Equal(t, "65534\n65534\n65534\n/\n", stdout.String())
+	assert.Empty(t, stderr.String())
+}
+
+func TestServiceIsolationRequiresCgroup(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// when
+	_, hostErr := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "host", Command: "true", Isolation: job.IsolationHost})
+	_, nsErr := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "ns", Command: "true", Isolation: job.IsolationNamespaces})
+	_, unknownErr := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "unknown", Command: "true", Isolation: "VM"})
+	_, defaultErr := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup(), job.WithDefaultIsolation(job.IsolationNamespacesWithNetwork))
+
+	// then
+	assert.NoError(t, hostErr)
+	assert.EqualError(t, nsErr, "isolation NAMESPACES can be used only if Jobs are executed in dedicated cgroups")
+	assert.True(t, job.IsInvalidInputError(nsErr))
+	assert.EqualError(t, unknownErr, `unknown isolation "VM"`)
+	assert.EqualError(t, defaultErr, "while validating default isolation: isolation NAMESPACES_WITH_NETWORK can be used only if Jobs are executed in dedicated cgroups")
+}
+
+func TestServiceRootfsValidation(t *testing.T) {
+	tests := map[string]struct {
+		opts   []job.ServiceOption
+		in     job.RunInput
+		errMsg string
+	}{
+		"Should reject rootfs if images are not enabled": {
+			in:     job.RunInput{Rootfs: "alpine"},
+			errMsg: "rootfs images are not enabled on Agent",
+		},
+		"Should reject rootfs with host isolation": {
+			opts:   []job.ServiceOption{job.WithRootfsProvider(fakeRootfsProvider{"alpine": "/images/alpine"})},
+			in:     job.RunInput{Rootfs: "alpine", Isolation: job.IsolationHost},
+			errMsg: "rootfs can be used only with NAMESPACES or NAMESPACES_WITH_NETWORK isolation",
+		},
+		"Should reject read-only paths without rootfs": {
+			in:     job.RunInput{ReadOnlyPaths: []string{"/etc/resolv.conf"}},
+			errMsg: "read-only paths can be used only together with rootfs",
+		},
+		"Should reject relative read-only path": {
+			in:     job.RunInput{Rootfs: "alpine", ReadOnlyPaths: []string{"etc/resolv.conf"}},
+			errMsg: `read-only path "etc/resolv.conf" must be an absolute path`,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+			require.NoError(t, err)
+			defer flog.Shutdown()
+
+			svc, err := job.NewService(repo.NewInMemory(), flog, append(tc.opts, job.WithoutCgroup())...)
+			require.NoError(t, err)
+
+			in := tc.in
+			in.Tenant = tenant
+			in.Name = "rootfs"
+			in.Command = "true"
+
+			// when
+			_, err = svc.Run(context.Background(), in)
+
+			// then
+			assert.EqualError(t, err, tc.errMsg)
+			assert.True(t, job.IsInvalidInputError(err))
+		})
+	}
+}
+
+func TestServiceSecurityProfileValidation(t *testing.T) {
+	profiles := &security.Profiles{
+		Profiles: map[string]security.Profile{
+			"restricted": {NoNewPrivileges: true},
+		},
+	}
+
+	tests := map[string]struct {
+		opts   []job.ServiceOption
+		in     job.RunInput
+		errMsg string
+	}{
+		"Should reject profile if profiles are not configured": {
+			in:     job.RunInput{SecurityProfile: "restricted"},
+			errMsg: "security profiles are not configured on Agent",
+		},
+		"Should reject unknown profile": {
+			opts:   []job.ServiceOption{job.WithSecurityProfiles(profiles)},
+			in:     job.RunInput{SecurityProfile: "unknown"},
+			errMsg: `unknown security profile "unknown"`,
+		},
+		"Should reject profile without dedicated cgroups": {
+			opts:   []job.ServiceOption{job.WithSecurityProfiles(profiles)},
+			in:     job.RunInput{SecurityProfile: "restricted"},
+			errMsg: "security profiles can be used only if Jobs are executed in dedicated cgroups",
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+			require.NoError(t, err)
+			defer flog.Shutdown()
+
+			svc, err := job.NewService(repo.NewInMemory(), flog, append(tc.opts, job.WithoutCgroup())...)
+			require.NoError(t, err)
+
+			in := tc.in
+			in.Tenant = tenant
+			in.Name = "hardened"
+			in.Command = "true"
+
+			// when
+			_, err = svc.Run(context.Background(), in)
+
+			// then
+			assert.EqualError(t, err, tc.errMsg)
+			assert.True(t, job.IsInvalidInputError(err))
+		})
+	}
+}
+
+func TestServiceDelete(t *testing.T) {
+	// given
+	logsDir := t.TempDir()
+	flog, err := file.NewLogger(file.WithLogsDir(logsDir))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	sleeper, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "sleeper", Command: "sleep", Args: []string{"60"}})
+	require.NoError(t, err)
+
+	// when
+	_, err = svc.Delete(ctx, job.DeleteInput{ID: sleeper.ID})
+
+	// then
+	assert.EqualError(t, err, fmt.Sprintf("Job %q is not finished, current status: RUNNING", sleeper.ID))
+	assert.True(t, job.IsFailedPreconditionError(err))
+
+	// when
+	_, err = svc.Stop(ctx, job.StopInput{ID: sleeper.ID, GracePeriod: time.Second})
+	require.NoError(t, err)
+	_, err = svc.Delete(ctx, job.DeleteInput{ID: sleeper.ID})
+
+	// then
+	require.NoError(t, err)
+
+	_, err = svc.Get(ctx, job.GetInput{ID: sleeper.ID})
+	assert.True(t, job.IsNotFoundError(err))
+	assert.NoFileExists(t, filepath.Join(logsDir, sleeper.ID))
+
+	reused, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "sleeper", Command: "true"})
+	require.NoError(t, err, "name of the deleted Job should be reusable")
+	assert.NotEqual(t, sleeper.ID, reused.ID)
+	_, err = svc.Wait(ctx, job.WaitInput{ID: reused.ID})
+	assert.NoError(t, err)
+}
+
+func TestServiceWaitAndDeleteRightAfterExit(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 20; i++ {
+		// output is big enough, so draining it takes a while after the process exits
+		run, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: fmt.Sprintf("seq-%d", i), Command: "seq", Args: []string{"50000"}})
+		require.NoError(t, err)
+
+		// final status is stored before the Job's resources are released
+		require.Eventually(t, func() bool {
+			out, err := svc.Get(ctx, job.GetInput{ID: run.ID})
+			return err == nil && out.Status.IsFinished()
+		}, 5*time.Second, time.Millisecond)
+
+		// when
+		_, err = svc.Wait(ctx, job.WaitInput{ID: run.ID})
+		require.NoError(t, err)
+
+		// then
+		logs, err := svc.StreamLogs(ctx, job.StreamLogsInput{ID: run.ID})
+		require.NoError(t, err)
+		var stdout, stderr bytes.Buffer
+		require.NoError(t, job.ForwardStreamLogs(ctx, &stdout, &stderr, logs))
+		assert.True(t, strings.HasSuffix(stdout.String(), "\n50000\n"), "whole output should be available")
+
+		_, err = svc.Delete(ctx, job.DeleteInput{ID: run.ID})
+		require.NoError(t, err)
+	}
+}
+
+func TestServiceNames(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+	require.NoError(t, err)
+	defer svc.Shutdown()
+
+	ctx := context.Background()
+
+	t.Run("Should scope names to tenant namespaces", func(t *testing.T) {
+		// when
+		ricky, err := svc.Run(ctx, job.RunInput{Tenant: "Ricky", Name: "build", Command: "true"})
+		require.NoError(t, err)
+		morty, err := svc.Run(ctx, job.RunInput{Tenant: "Morty", Name: "build", Command: "true"})
+		require.NoError(t, err)
+		_, conflictErr := svc.Run(ctx, job.RunInput{Tenant: "Ricky", Name: "build", Command: "true"})
+
+		// then
+		assert.Equal(t, "build", ricky.Name)
+		assert.Equal(t, "build", morty.Name)
+		assert.NotEqual(t, ricky.ID, morty.ID)
+		assert.EqualError(t, conflictErr, `Job "build" already exists in "Ricky" namespace`)
+		assert.True(t, job.IsConflictError(conflictErr))
+
+		out, err := svc.Get(ctx, job.GetInput{ID: morty.ID})
+		require.NoError(t, err)
+		assert.Equal(t, morty.ID, out.ID)
+		assert.Equal(t, "build", out.Name)
+		assert.Equal(t, "Morty", out.CreatedBy)
+	})
+
+	t.Run("Should generate unique names", func(t *testing.T) {
+		// when
+		first, err := svc.Run(ctx, job.RunInput{Tenant: "Ricky", GenerateName: "nightly-", Command: "true"})
+		require.NoError(t, err)
+		second, err := svc.Run(ctx, job.RunInput{Tenant: "Ricky", GenerateName: "nightly-", Command: "true"})
+		require.NoError(t, err)
+
+		// then
+		assert.Regexp(t, `^nightly-\w{5}$`, first.Name)
+		assert.Regexp(t, `^nightly-\w{5}$`, second.Name)
+		assert.NotEqual(t, first.Name, second.Name)
+	})
+
+	t.Run("Should reject invalid names", func(t *testing.T) {
+		tests := map[string]struct {
+			in     job.RunInput
+			errMsg string
+		}{
+			"Should reject missing name": {
+				errMsg: "name or generate name is required",
+			},
+			"Should reject both name and generate name": {
+				in:     job.RunInput{Name: "build", GenerateName: "build-"},
+				errMsg: "name and generate name are mutually exclusive",
+			},
+			"Should reject namespaced name": {
+				in:     job.RunInput{Name: "Morty/build"},
+				errMsg: `name "Morty/build" cannot contain "/"`,
+			},
+			"Should reject name in the ID format": {
+				in:     job.RunInput{Name: "4f1c2a9be07d53a6c8e1"},
+				errMsg: `name "4f1c2a9be07d53a6c8e1" cannot have the format of Job ID`,
+			},
+			"Should reject namespaced generate name": {
+				in:     job.RunInput{GenerateName: "Morty/build-"},
+				errMsg: `generate name "Morty/build-" cannot contain "/"`,
+			},
+		}
+		for tn, tc := range tests {
+			t.Run(tn, func(t *testing.T) {
+				in := tc.in
+				in.Tenant = "Ricky"
+				in.Command = "true"
+
+				// when
+				_, err := svc.Run(ctx, in)
+
+				// then
+				assert.EqualError(t, err, tc.errMsg)
+				assert.True(t, job.IsInvalidInputError(err))
+			})
+		}
+	})
+}
+
+func TestRetentionCollector(t *testing.T) {
+	tests := map[string]struct {
+		policy  job.RetentionPolicy
+		expKept []string
+	}{
+		"Should keep the most recent Jobs of each tenant": {
+			policy:  job.RetentionPolicy{MaxCountPerTenant: 1},
+			expKept: []string{"ricky-2", "morty-1", "ricky-running"},
+		},
+		"Should delete Jobs older than max age": {
+			policy:  job.RetentionPolicy{MaxAge: time.Nanosecond},
+			expKept: []string{"ricky-running"},
+		},
+		"Should keep all Jobs within limits": {
+			policy:  job.RetentionPolicy{MaxAge: time.Hour, MaxCountPerTenant: 2},
+			expKept: []string{"ricky-1", "ricky-2", "morty-1", "ricky-running"},
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+			require.NoError(t, err)
+			defer flog.Shutdown()
+
+			svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+			require.NoError(t, err)
+			defer svc.Shutdown()
+
+			ctx := context.Background()
+			for _, in := range []job.RunInput{
+				{Tenant: "Ricky", Name: "ricky-1", Command: "true"},
+				{Tenant: "Morty", Name: "morty-1", Command: "true"},
+				{Tenant: "Ricky", Name: "ricky-2", Command: "true"},
+			} {
+				run, err := svc.Run(ctx, in)
+				require.NoError(t, err)
+				_, err = svc.Wait(ctx, job.WaitInput{ID: run.ID})
+				require.NoError(t, err)
+			}
+			_, err = svc.Run(ctx, job.RunInput{Tenant: "Ricky", Name: "ricky-running", Command: "sleep", Args: []string{"60"}})
+			require.NoError(t, err)
+
+			tc.policy.Interval = time.Hour // only explicit runs
+			collector, err := job.NewRetentionCollector(svc, tc.policy)
+			require.NoError(t, err)
+			defer collector.Shutdown()
+
+			// when
+			err = collector.Collect(ctx)
+
+			// then
+			require.NoError(t, err)
+
+			out, err := svc.List(ctx, job.ListInput{})
+			require.NoError(t, err)
+			var kept []string
+			for _, item := range out.Jobs {
+				kept = append(kept, item.Name)
+			}
+			assert.ElementsMatch(t, tc.expKept, kept)
+		})
+	}
+}
+
+func TestServiceAttach(t *testing.T) {
+	// given
+	flog, err := file.NewLogger(file.WithLogsDir(t.TempDir()))
+	require.NoError(t, err)
+	defer flog.Shutdown()
+
+	svc, err := job.NewService(repo.NewInMemory(), flog, job.WithoutCgroup())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var cat *job.RunOutput
+	t.Run("Should forward stdin", func(t *testing.T) {
+		cat, err = svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "cat", Command: "cat", Stdin: true})
+		require.NoError(t, err)
+
+		// when
+		out, err := svc.Attach(ctx, job.AttachInput{ID: cat.ID})
+		require.NoError(t, err)
+		_, err = out.Stdin.Write([]byte("hello\n"))
+		require.NoError(t, err)
+		require.NoError(t, out.Stdin.Close())
+
+		// then
+		assert.False(t, out.TTY)
+		var stdout, stderr bytes.Buffer
+		require.NoError(t, job.ForwardStreamLogs(ctx, &stdout, &stderr, &job.StreamLogsOutput{Output: out.Output, Error: out.Error}))
+		assert.Equal(t, "hello\n", stdout.String())
+
+		waitOut, err := svc.Wait(ctx, job.WaitInput{ID: cat.ID})
+		require.NoError(t, err)
+		assert.Equal(t, job.Succeeded, waitOut.Status)
+	})
+
+	t.Run("Should forward stdin and output via pseudo-terminal", func(t *testing.T) {
+		shell, err := svc.Run(ctx, job.RunInput{Tenant: tenant, Name: "shell", Command: "sh", Args: []string{"-c", `read line; echo "got $line"`}, TTY: true})
+		require.NoError(t, err)
+
+		// when
+		out, err := svc.Attach(ctx, job.AttachInput{ID: shell.ID})
+		require.NoError(t, err)
+		require.NoError(t, out.Resize(24, 80))
+		_, err = out.Stdin.Write([]byte("hi\n"))
+		require.NoError(t, err)
+
+		// then
+		assert.True(t, out.TTY)
+		var output bytes.Buffer
+		require.NoError(t, job.ForwardStreamLogs(ctx, &output, &output, &job.StreamLogsOutput{Output: out.Output, Error: out.Error}))
+		assert.Contains(t, output.String(), "got hi")
+
+		waitOut, err := svc.Wait(ctx, job.WaitInput{ID: shell.ID})
+		require.NoError(t, err)
+		assert.Equal(t, job.Succeeded, waitOut.Status)
+	})
+
+	t.Run("Should reject finished Job", func(t *testing.T) {
+		// when
+		_, err := svc.Attach(ctx, job.AttachInput{ID: cat.ID})
+
+		// then
+		assert.True(t, job.IsFailedPreconditionError(err))
+	})
+}
+
+// fakeRootfsProvider returns rootfs paths for image names.
+type fakeRootfsProvider map[string]string
+
+func (f fakeRootfsProvider) RootfsPath(name string) (string, error) {
+	path, found := f[name]
+	if !found {
+		return "", fmt.Errorf("image %q not found", name)
+	}
+	return path, nil
+}
@@ -0,0 +1,32 @@
+package job
+
+import (
+	"strconv"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// ParseSignal returns a signal for a given name. Name is case-insensitive and the "SIG" prefix is optional,
+// e.g. "SIGHUP", "hup" and "1" represent the same signal.
+func ParseSignal(name string) (syscall.Signal, error) {
+	if num, err := strconv.Atoi(name); err == nil {
+		sig := syscall.Signal(num)
+		if unix.SignalName(sig) == "" {
+			return 0, NewInvalidInputError("unknown signal number %d", num)
+		}
+		return sig, nil
+	}
+
+	normalized := strings.ToUpper(strings.TrimSpace(name))
+	if !strings.HasPrefix(normalized, "SIG") {
+		normalized = "SIG" + normalized
+	}
+
+	sig := unix.SignalNum(normalized)
+	if sig == 0 {
+		return 0, NewInvalidInputError("unknown signal %q", name)
+	}
+	return sig, nil
+}
@@ -0,0 +1,41 @@
+package job_test
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mszostok/job-runner/pkg/job"
+)
+
+func TestParseSignal(t *testing.T) {
+	t.Run("Should parse valid signals", func(t *testing.T) {
+		tests := map[string]syscall.Signal{
+			"SIGINT":  syscall.SIGINT,
+			"sigquit": syscall.SIGQUIT,
+			"HUP":     syscall.SIGHUP,
+			"term":    syscall.SIGTERM,
+			"9":       syscall.SIGKILL,
+		}
+		for name, expSig := range tests {
+			// when
+			sig, err := job.ParseSignal(name)
+
+			// then
+			require.NoError(t, err, name)
+			assert.Equal(t, expSig, sig, name)
+		}
+	})
+
+	t.Run("Should return error for unknown signals", func(t *testing.T) {
+		for _, name := range []string{"", "SIGFOO", "BAR", "0", "1000"} {
+			// when
+			_, err := job.ParseSignal(name)
+
+			// then
+			assert.True(t, job.IsInvalidInputError(err), name)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package job
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/creack/pty"
+
+	"github.com/mszostok/job-runner/pkg/file"
+)
+
+// ttyDrainTimeout represents how long the pseudo-terminal output is copied after the Cmd finished.
+// It's exceeded only if the pseudo-terminal is still held by processes that outlived the Cmd.
+const ttyDrainTimeout = time.Second
+
+// ttyEOF is the default VEOF character, i.e. Ctrl+D.
+const ttyEOF = 0x04
+
+// stdio holds standard streams of the Cmd's process. They are created once per Cmd and kept open across restarts,
+// so attached clients are not affected by them.
+type stdio struct {
+	sink *file.Sink
+
+	// stdin is passed to the process as its stdin. Nil if the Cmd was started without stdin.
+	stdin *os.File
+	// stdinWriter forwards data to stdin.
+	stdinWriter *os.File
+
+	// ptmx is the pseudo-terminal master, and tty is its slave passed to the process as all standard streams.
+	// Both are nil if the Cmd was started without pseudo-terminal.
+	ptmx *os.File
+	tty  *os.File
+	// ttyCopied is closed when the pseudo-terminal output was copied to the sink.
+	ttyCopied chan struct{}
+}
+
+func newStdio(in RunInput, sink *file.Sink) (*stdio, error) {
+	out := &stdio{sink: sink}
+
+	switch {
+	case in.TTY:
+		ptmx, tty, err := pty.Open()
+		if err != nil {
+			return nil, errors.Wrap(err, "while allocating pseudo-terminal")
+		}
+		out.ptmx, out.tty = ptmx, tty
+		out.ttyCopied = make(chan struct{})
+		go func() {
+			defer close(out.ttyCopied)
+			// reading fails with EIO once all pseudo-terminal slave descriptors are closed
+			_, _ = io.Copy(sink.Stdout, ptmx)
+		}()
+	case in.Stdin:
+		r, w, err := os.Pipe()
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating stdin pipe")
+		}
+		out.stdin, out.stdinWriter = r, w
+	}
+
+	return out, nil
+}
+
+// apply sets standard streams of a given command. If the Cmd has a pseudo-terminal, the process becomes a session
+// leader with the pseudo-terminal as its controlling terminal, so e.g. Ctrl+C is delivered to it as SIGINT.
+func (s *stdio) apply(cmd *exec.Cmd) {
+	if s.tty == nil {
+		if s.stdin != nil {
+			cmd.Stdin = s.stdin
+		}
+		cmd.Stdout = s.sink.Stdout
+		cmd.Stderr = s.sink.Stderr
+		return
+	}
+
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = s.tty, s.tty, s.tty
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setsid = true
+	cmd.SysProcAttr.Setctty = true
+	cmd.SysProcAttr.Ctty = 0 // stdin in the child process
+}
+
+// stdinForwarder returns writer which forwards data to the Cmd's stdin. Returns nil if the Cmd has no stdin.
+func (s *stdio) stdinForwarder() io.WriteCloser {
+	switch {
+	case s.ptmx != nil:
+		return ttyStdin{ptmx: s.ptmx}
+	case s.stdinWriter != nil:
+		return s.stdinWriter
+	default:
+		return nil
+	}
+}
+
+// resize changes the size of the pseudo-terminal. It's no-op if the Cmd has no pseudo-terminal.
+func (s *stdio) resize(rows, cols uint16) error {
+	if s.ptmx == nil {
+		return nil
+	}
+	return pty.Setsize(s.ptmx, &pty.Winsize{Rows: rows, Cols: cols})
+}
+
+// close closes standard streams and waits until the pseudo-terminal output is copied to the sink.
+// It must be called before the sink is released.
+func (s *stdio) close() {
+	for _, f := range []*os.File{s.stdin, s.stdinWriter, s.tty} {
+		if f != nil {
+			_ = f.Close()
+		}
+	}
+	if s.ptmx == nil {
+		return
+	}
+
+	select {
+	case <-s.ttyCopied:
+	case <-time.After(ttyDrainTimeout):
+	}
+	_ = s.ptmx.Close()
+	<-s.ttyCopied
+}
+
+// ttyStdin forwards data to the pseudo-terminal. Closing it sends the EOF character, so the terminal stays open.
+type ttyStdin struct {
+	ptmx *os.File
+}
+
+func (t ttyStdin) Write(p []byte) (int, error) {
+	return t.ptmx.Write(p)
+}
+
+func (t ttyStdin) Close() error {
+	_, err := t.ptmx.Write([]byte{ttyEOF})
+	return err
+}
@@ -2,7 +2,13 @@ package job
 
 import (
 	"fmt"
+	"io"
+	"syscall"
 	"time"
+
+	"github.com/mszostok/job-runner/pkg/cgroup"
+	"github.com/mszostok/job-runner/pkg/file"
+	"github.com/mszostok/job-runner/pkg/security"
 )
 
 // Status specifies human-readable Cmd status.
@@ -13,18 +19,41 @@ const (
 	Failed     Status = "FAILED"
 	Succeeded  Status = "SUCCEEDED"
 	Terminated Status = "TERMINATED"
+	// Lost indicates that Cmd was running when Agent stopped, so its exit status is unknown.
+	Lost Status = "LOST"
+	// Queued indicates that Cmd waits until concurrency limits allow to start it.
+	Queued Status = "QUEUED"
 )
 
 func (s Status) IsFinished() bool {
-	return s != Running
+	return s != Running && s != Queued
 }
 
+// TerminationReason specifies why Cmd was terminated.
+type TerminationReason string
+
+const (
+	// OOMKilled indicates that Cmd was killed by the kernel OOM killer as it exceeded its memory limit.
+	OOMKilled TerminationReason = "OOM_KILLED"
+	// StopRequested indicates that Cmd was stopped on user request.
+	StopRequested TerminationReason = "STOP_REQUESTED"
+	// AgentShutdown indicates that Cmd was stopped as Agent was shutting down.
+	AgentShutdown TerminationReason = "AGENT_SHUTDOWN"
+	// DeadlineExceeded indicates that Cmd was stopped as it was running longer than its timeout.
+	DeadlineExceeded TerminationReason = "DEADLINE_EXCEEDED"
+	// StartFailed indicates that queued Cmd's process could not be started.
+	StartFailed TerminationReason = "START_FAILED"
+)
+
 type RunInput struct {
 	// Tenant specifies the tenant of a given Job.
 	// TODO: rename to Tenant to have better consistency.
 	Tenant string
-	// Name specifies Cmd name.
+	// Name specifies Cmd name. It's unique within the tenant namespace.
 	Name string
+	// GenerateName specifies the prefix of the Cmd name. Random suffix is appended to it, so the name is unique.
+	// It's used only if Name is empty.
+	GenerateName string
 	// Command is the path of the command to run.
 	Command string
 	// Args holds command line arguments.
@@ -32,53 +61,356 @@ type RunInput struct {
 	// Env specifies the environment of the process.
 	// Each entry is of the form "key=value".
 	Env []string
-	// TODO(simplification): Resources specifies Cmd's system resources limits.
-	// In the first version not supported. Use globals defined on Agent side.
-	//Resources Resources
+	// Resources specifies Cmd's system resources limits.
+	// Not specified controllers are configured with DefaultProcResources.
+	Resources *cgroup.Resources
+	// Timeout specifies the maximum time the Cmd can run. Once exceeded, the Cmd is stopped in the same way as by Stop.
+	// Zero means no timeout.
+	Timeout time.Duration
+	// TimeoutGracePeriod represents a period of time given to the Cmd to terminate gracefully once Timeout is exceeded.
+	// Zero means that Cmd is never killed.
+	TimeoutGracePeriod time.Duration
+	// Restart specifies if and how the Cmd is restarted once its process exited.
+	// Restarted Cmd runs in the same cgroup and its output is appended to the same logs.
+	Restart RestartOptions
+	// Priority is used to order queued Cmds if Service is configured with PriorityOrdering. Higher value goes first.
+	Priority int
+	// WorkingDir specifies the working directory of the process. It must be an absolute path.
+	// If empty, the process runs in the Agent's working directory.
+	WorkingDir string
+	// User specifies the user, name or UID, the process runs as. If empty, the default user from
+	// the IdentityPolicy is used, or the Agent's user if there is no policy.
+	User string
+	// Group specifies the primary group, name or GID, the process runs as. If empty, the user's primary group is used.
+	Group string
+	// SupplementaryGroups holds additional groups, names or GIDs, of the process.
+	SupplementaryGroups []string
+	// Umask specifies the file mode creation mask of the process in octal notation, e.g. "0027".
+	// If empty, the Agent's umask is inherited.
+	Umask string
+	// Stdin specifies whether the Cmd's stdin is kept open, so data can be written to it via Attach.
+	Stdin bool
+	// TTY specifies whether a pseudo-terminal is allocated for the Cmd. The Cmd's stdin, stdout and stderr
+	// are attached to it, so its whole output is stored as stdout.
+	TTY bool
+	// Isolation specifies Linux namespaces in which the Cmd runs. If empty, the Service's default is used.
+	Isolation Isolation
+	// Rootfs specifies the name of the image whose root filesystem the Cmd runs in. It requires namespaced Isolation.
+	// If empty, the Cmd runs in the host root filesystem. The image is not modified, the Cmd's changes are kept in memory
+	// and discarded once its process exits. User and groups must be specified by IDs, as names are not resolved in the image.
+	Rootfs string
+	// ReadOnlyPaths holds absolute paths on the host which are bind-mounted read-only at the same paths in the rootfs.
+	ReadOnlyPaths []string
+	// SecurityProfile specifies the name of the profile the Cmd is hardened with. It requires dedicated cgroups.
+	// If empty, the Service's default is used.
+	SecurityProfile string
+
+	// id is generated when the Cmd is requested. Cmd's resources, e.g. logs and cgroup, are named after it.
+	id string
+	// credential is resolved from the user and groups when the Cmd is requested. Nil means the Agent's credential.
+	credential *syscall.Credential
+	// rootfsPath is resolved from the Rootfs image when the Cmd is requested. Empty means the host root filesystem.
+	rootfsPath string
+	// securityProfile is resolved from the SecurityProfile when the Cmd is requested. Nil means no restrictions.
+	securityProfile *security.Profile
 }
 
-type RunOutput struct{}
+type RunOutput struct {
+	// ID specifies the generated Cmd ID. It's unique across all tenants.
+	ID string
+	// Name specifies Cmd name. It's generated if GenerateName was requested.
+	Name string
+}
+
+// Template describes Cmds created on behalf of the tenant by other components, e.g. ScheduledJobs or Workflows.
+type Template struct {
+	// Command is the path of the command to run.
+	Command string
+	// Args holds command line arguments.
+	Args []string
+	// Env specifies the environment of the process.
+	// Each entry is of the form "key=value".
+	Env []string
+	// Resources specifies Cmd's system resources limits.
+	Resources *cgroup.Resources
+	// Timeout specifies the maximum time the Cmd can run. Zero means no timeout.
+	Timeout time.Duration
+	// TimeoutGracePeriod represents a period of time given to the Cmd to terminate gracefully once Timeout is exceeded.
+	TimeoutGracePeriod time.Duration
+	// Restart specifies if and how the Cmd is restarted once its process exited.
+	Restart RestartOptions
+	// Priority is used to order queued Cmds if Service is configured with PriorityOrdering.
+	Priority int
+	// WorkingDir specifies the working directory of the process.
+	WorkingDir string
+	// User specifies the user, name or UID, the process runs as.
+	User string
+	// Group specifies the primary group, name or GID, the process runs as.
+	Group string
+	// SupplementaryGroups holds additional groups, names or GIDs, of the process.
+	SupplementaryGroups []string
+	// Umask specifies the file mode creation mask of the process in octal notation.
+	Umask string
+	// Isolation specifies Linux namespaces in which the Cmd runs.
+	Isolation Isolation
+	// Rootfs specifies the name of the image whose root filesystem the Cmd runs in.
+	Rootfs string
+	// ReadOnlyPaths holds host paths which are bind-mounted read-only in the rootfs.
+	ReadOnlyPaths []string
+	// SecurityProfile specifies the name of the profile the Cmd is hardened with.
+	SecurityProfile string
+}
+
+// Validate returns error if template is not valid. Resources are validated when the Cmd is run.
+func (t Template) Validate() error {
+	if t.Command == "" {
+		return NewInvalidInputError("command cannot be empty")
+	}
+	if t.Timeout < 0 || t.TimeoutGracePeriod < 0 {
+		return NewInvalidInputError("timeout and timeout grace period cannot be negative")
+	}
+	if err := t.Isolation.Validate(); err != nil {
+		return err
+	}
+	return t.Restart.Validate()
+}
+
+// RunInput returns input for running Cmd with a given name from the template.
+func (t Template) RunInput(tenant, name string) RunInput {
+	return RunInput{
+		Tenant:              tenant,
+		Name:                name,
+		Command:             t.Command,
+		Args:                t.Args,
+		Env:                 t.Env,
+		Resources:           t.Resources,
+		Timeout:             t.Timeout,
+		TimeoutGracePeriod:  t.TimeoutGracePeriod,
+		Restart:             t.Restart,
+		Priority:            t.Priority,
+		WorkingDir:          t.WorkingDir,
+		User:                t.User,
+		Group:               t.Group,
+		SupplementaryGroups: t.SupplementaryGroups,
+		Umask:               t.Umask,
+		Isolation:           t.Isolation,
+		Rootfs:              t.Rootfs,
+		ReadOnlyPaths:       t.ReadOnlyPaths,
+		SecurityProfile:     t.SecurityProfile,
+	}
+}
 
 type GetInput struct {
-	// Name specifies Cmd name.
-	Name string
+	// ID specifies Cmd ID.
+	ID string
 }
 
 type GetOutput struct {
+	// ID specifies Cmd ID.
+	ID string
+	// Name specifies Cmd name.
+	Name string
 	// CreatedBy specifies the tenant that executed a given Cmd.
 	CreatedBy string
+	// PID specifies the process ID of the Cmd's current attempt.
+	PID int
 	// Status of a given Cmd.
 	Status Status
 	// ExitCode of the exited process. While Status in Running, exit code should be ignored.
 	ExitCode int
+	// CreatedAt specifies when the Cmd was requested.
+	CreatedAt time.Time
+	// StartedAt specifies when the Cmd's process was started.
+	StartedAt time.Time
+	// FinishedAt specifies when the Cmd's process finished. Zero if it is still running or the finish time is unknown.
+	FinishedAt time.Time
+	// TerminationReason specifies why the Cmd was terminated. Empty if the Cmd exited on its own.
+	TerminationReason TerminationReason
+	// Signal specifies the name of the signal that killed the Cmd's process, e.g. "SIGKILL".
+	Signal string
+	// StoppedBy specifies the tenant that requested the Cmd stop.
+	StoppedBy string
+	// Attempt specifies the number of the current, or the last, Cmd's execution. It starts from 1.
+	Attempt int
+	// Restarts specifies how many times the Cmd was restarted.
+	Restarts int
+	// Attempts holds all Cmd's executions. The last one is the current attempt.
+	Attempts []Attempt
+	// QueuePosition specifies the Cmd's position in the queue, starting from 1. Zero if Cmd is not queued.
+	QueuePosition int
+	// SecurityProfile specifies the name of the profile the Cmd was hardened with. Empty if it runs unrestricted.
+	SecurityProfile string
+	// Error specifies why the Cmd's process could not be started. Set only if TerminationReason is StartFailed.
+	Error string
+}
+
+// Attempt represents a single execution of the Cmd.
+type Attempt struct {
+	// StartedAt specifies when the attempt was started.
+	StartedAt time.Time
+	// FinishedAt specifies when the attempt finished. Zero if it is still running.
+	FinishedAt time.Time
+	// ExitCode of the attempt's process.
+	ExitCode int
+	// Signal specifies the name of the signal that killed the attempt's process.
+	Signal string
 }
 
 func (g GetOutput) String() string {
 	switch g.Status {
 	case Running:
 		return fmt.Sprintf("Job created by %q is still running", g.CreatedBy)
+	case Queued:
+		return fmt.Sprintf("Job created by %q is queued at position %d", g.CreatedBy, g.QueuePosition)
 	default:
 		return fmt.Sprintf("Job created by %q is in %q state with exit code %d", g.CreatedBy, g.Status, g.ExitCode)
 	}
 }
 
-type StreamLogsInput struct {
+type ListInput struct {
+	// Tenant returns only Cmds created by a given tenant. If empty, Cmds of all tenants are returned.
+	Tenant string
+	// Statuses returns only Cmds in one of a given statuses. If empty, Cmds in all statuses are returned.
+	Statuses []Status
+	// NamePrefix returns only Cmds which name starts with a given prefix.
+	NamePrefix string
+	// PageSize specifies the maximum number of returned Cmds. Zero means no limit.
+	PageSize int
+	// PageToken specifies the page to return. Use the NextPageToken returned by previous List call.
+	PageToken string
+}
+
+type ListOutput struct {
+	// Jobs holds Cmds sorted by name. Cmds with the same name, but created by different tenants, are sorted by ID.
+	Jobs []ListItem
+	// NextPageToken is a token to retrieve the next page. Empty if there are no more Cmds.
+	NextPageToken string
+}
+
+type ListItem struct {
+	// ID specifies Cmd ID.
+	ID string
 	// Name specifies Cmd name.
 	Name string
+	// CreatedBy specifies the tenant that executed a given Cmd.
+	CreatedBy string
+	// Status of a given Cmd.
+	Status Status
+	// ExitCode of the exited process. While Status in Running, exit code should be ignored.
+	ExitCode int
+	// CreatedAt specifies when the Cmd was requested.
+	CreatedAt time.Time
+	// StartedAt specifies when the Cmd's process was started.
+	StartedAt time.Time
+	// FinishedAt specifies when the Cmd's process finished. Zero if it is still running or the finish time is unknown.
+	FinishedAt time.Time
+	// TerminationReason specifies why the Cmd was terminated. Empty if the Cmd exited on its own.
+	TerminationReason TerminationReason
+	// Signal specifies the name of the signal that killed the Cmd's process, e.g. "SIGKILL".
+	Signal string
+	// StoppedBy specifies the tenant that requested the Cmd stop.
+	StoppedBy string
+	// Restarts specifies how many times the Cmd was restarted.
+	Restarts int
+	// QueuePosition specifies the Cmd's position in the queue, starting from 1. Zero if Cmd is not queued.
+	QueuePosition int
+}
+
+type GetStatsInput struct {
+	// ID specifies Cmd ID.
+	ID string
+}
+
+type GetStatsOutput struct {
+	// Status of a given Cmd. Stats are not updated once the Cmd is finished.
+	Status Status
+	// Stats holds resources usage of a given Cmd.
+	Stats cgroup.GroupStats
+}
+
+type StreamLogsInput struct {
+	// ID specifies Cmd ID.
+	ID string
+	// Streams specifies which output streams should be returned. If not specified, all streams are returned.
+	Streams []file.Stream
+	// Follow specifies whether to stream new logs until the Cmd finishes.
+	Follow bool
+	// TailLines specifies the number of lines from the end of the logs to return. If not specified, all lines are returned.
+	TailLines int64
+	// SinceOffset specifies the logs byte offset from which to start streaming, e.g. the offset of the last received chunk.
+	SinceOffset int64
+	// LimitBytes specifies the maximum number of bytes to return. If not specified, there is no limit.
+	LimitBytes int64
 }
 
 type StreamLogsOutput struct {
 	// Output represents the streamed Cmd logs. It is from start of Cmd execution.
-	Output <-chan []byte
+	// Each chunk holds data written to a single output stream.
+	Output <-chan file.Chunk
 	// Error allows communicating issues encountered during logs streaming.
 	Error <-chan error
 }
 
+type AttachInput struct {
+	// ID specifies Cmd ID.
+	ID string
+}
+
+type AttachOutput struct {
+	// TTY specifies whether the Cmd has a pseudo-terminal.
+	TTY bool
+	// Stdin forwards data to the Cmd's stdin. Nil if the Cmd was started without stdin.
+	// Closing it closes the Cmd's stdin for all attached clients. For pseudo-terminal, the EOF character is sent instead.
+	Stdin io.WriteCloser
+	// Resize changes the size of the Cmd's pseudo-terminal. It's no-op if the Cmd has no pseudo-terminal.
+	Resize func(rows, cols uint16) error
+	// Output represents the Cmd's output produced since attaching. It's closed when the Cmd finishes.
+	Output <-chan file.Chunk
+	// Error allows communicating issues encountered during output streaming.
+	Error <-chan error
+}
+
 type StopInput struct {
-	// Name specifies Cmd name.
-	Name string
+	// ID specifies Cmd ID.
+	ID string
 	// GracePeriod represents a period of time given to the Cmd to terminate gracefully.
 	GracePeriod time.Duration
+	// Signal specifies the signal sent to the Cmd to terminate gracefully. Defaults to SIGTERM.
+	Signal syscall.Signal
+	// StoppedBy specifies the tenant that requested the Cmd stop.
+	StoppedBy string
+}
+
+type SignalInput struct {
+	// ID specifies Cmd ID.
+	ID string
+	// Signal specifies the signal sent to all Cmd's processes.
+	Signal syscall.Signal
+}
+
+type SignalOutput struct{}
+
+type DeleteInput struct {
+	// ID specifies Cmd ID.
+	ID string
+	// Force allows deleting lost Cmd whose processes are still running. Such processes are killed.
+	Force bool
+}
+
+type DeleteOutput struct{}
+
+type WaitInput struct {
+	// ID specifies Cmd ID.
+	ID string
+}
+
+type WaitOutput struct {
+	// Status of a given Cmd.
+	Status Status
+	// ExitCode of the exited process.
+	ExitCode int
+	// TerminationReason specifies why the Cmd was terminated. Empty if the Cmd exited on its own.
+	TerminationReason TerminationReason
 }
 
 type StopOutput struct {
@@ -86,4 +418,8 @@ type StopOutput struct {
 	Status Status
 	// ExitCode of the exited process.
 	ExitCode int
+	// TerminationReason specifies why the Cmd was terminated. Empty if the Cmd exited on its own before it was stopped.
+	TerminationReason TerminationReason
+	// Signal specifies the name of the signal that killed the Cmd's process, e.g. "SIGKILL".
+	Signal string
 }
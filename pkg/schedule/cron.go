@@ -0,0 +1,201 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxScheduleLookup limits how far in the future the next activation time is searched for.
+// Expressions such as "0 0 30 2 *" never match, so the search cannot be unbounded.
+const maxScheduleLookup = 5 // years
+
+// field describes allowed values of a single cron expression field.
+type field struct {
+	name     string
+	min, max int
+	// names holds optional aliases, e.g. "JAN" for months.
+	names map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	// Both 0 and 7 represent Sunday.
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Expression represents a parsed cron expression in the standard five fields format,
+// that is minute, hour, day of month, month and day of week.
+type Expression struct {
+	minute, hour, dom, month, dow uint64
+	// If both day fields are restricted, a day matches if any of them matches, as in Vixie cron.
+	domRestricted, dowRestricted bool
+}
+
+// ParseExpression parses a given cron expression. Supported are lists (1,2), ranges (1-5), steps (*/15, 1-30/2),
+// month and day-of-week names (JAN, MON) and macros such as @hourly or @daily.
+func ParseExpression(raw string) (*Expression, error) {
+	expr := strings.TrimSpace(raw)
+	if macro, found := macros[strings.ToLower(expr)]; found {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, NewInvalidInputError("cron expression %q must have exactly 5 fields, got %d", raw, len(fields))
+	}
+
+	var (
+		out Expression
+		err error
+	)
+	if out.minute, err = parseField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+	if out.hour, err = parseField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+	if out.dom, err = parseField(fields[2], domField); err != nil {
+		return nil, err
+	}
+	if out.month, err = parseField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+	if out.dow, err = parseField(fields[4], dowField); err != nil {
+		return nil, err
+	}
+
+	// normalize Sunday
+	if out.dow&(1<<7) != 0 {
+		out.dow |= 1
+	}
+	out.domRestricted = !strings.HasPrefix(fields[2], "*")
+	out.dowRestricted = !strings.HasPrefix(fields[4], "*")
+
+	return &out, nil
+}
+
+// Next returns the first activation time after a given time. Returns zero time if there is no such time in the next years.
+func (e *Expression) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + maxScheduleLookup
+
+	for t.Year() <= limit {
+		switch {
+		case !has(e.month, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !e.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !has(e.hour, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !has(e.minute, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (e *Expression) dayMatches(t time.Time) bool {
+	dom, dow := has(e.dom, t.Day()), has(e.dow, int(t.Weekday()))
+	if e.domRestricted && e.dowRestricted {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+func parseField(raw string, f field) (uint64, error) {
+	var out uint64
+	for _, part := range strings.Split(raw, ",") {
+		bits, err := parseFieldPart(part, f)
+		if err != nil {
+			return 0, NewInvalidInputError("invalid %s field %q: %s", f.name, raw, err)
+		}
+		out |= bits
+	}
+	return out, nil
+}
+
+// parseFieldPart parses a single list entry, e.g. "*", "5", "1-5", "*/15" or "10-40/10".
+func parseFieldPart(raw string, f field) (uint64, error) {
+	rangePart, step := raw, 1
+	if idx := strings.Index(raw, "/"); idx >= 0 {
+		s, err := strconv.Atoi(raw[idx+1:])
+		if err != nil || s <= 0 {
+			return 0, fmt.Errorf("step must be a positive number")
+		}
+		rangePart, step = raw[:idx], s
+	}
+
+	var start, end int
+	switch {
+	case rangePart == "*":
+		start, end = f.min, f.max
+	case strings.Contains(rangePart, "-"):
+		idx := strings.Index(rangePart, "-")
+		var err error
+		if start, err = f.value(rangePart[:idx]); err != nil {
+			return 0, err
+		}
+		if end, err = f.value(rangePart[idx+1:]); err != nil {
+			return 0, err
+		}
+		if start > end {
+			return 0, fmt.Errorf("range start %d is greater than its end %d", start, end)
+		}
+	default:
+		v, err := f.value(rangePart)
+		if err != nil {
+			return 0, err
+		}
+		start, end = v, v
+		if step > 1 { // e.g. "5/15" means from 5 till the end of range every 15
+			end = f.max
+		}
+	}
+
+	var out uint64
+	for v := start; v <= end; v += step {
+		out |= 1 << uint(v)
+	}
+	return out, nil
+}
+
+func (f field) value(raw string) (int, error) {
+	if v, found := f.names[strings.ToUpper(raw)]; found {
+		return v, nil
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid value", raw)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d is out of range [%d, %d]", v, f.min, f.max)
+	}
+	return v, nil
+}
+
+func has(bits uint64, v int) bool {
+	return bits&(1<<uint(v)) != 0
+}
@@ -0,0 +1,133 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mszostok/job-runner/pkg/schedule"
+)
+
+func TestExpressionNext(t *testing.T) {
+	tests := map[string]struct {
+		expr     string
+		after    string
+		expected string
+	}{
+		"Should return the next minute": {
+			expr:     "* * * * *",
+			after:    "2022-03-08T10:00:30Z",
+			expected: "2022-03-08T10:01:00Z",
+		},
+		"Should support steps": {
+			expr:     "*/15 * * * *",
+			after:    "2022-03-08T10:16:00Z",
+			expected: "2022-03-08T10:30:00Z",
+		},
+		"Should support ranges with steps": {
+			expr:     "10-40/10 * * * *",
+			after:    "2022-03-08T10:41:00Z",
+			expected: "2022-03-08T11:10:00Z",
+		},
+		"Should support lists": {
+			expr:     "0 8,20 * * *",
+			after:    "2022-03-08T10:00:00Z",
+			expected: "2022-03-08T20:00:00Z",
+		},
+		"Should move to the next month": {
+			expr:     "0 0 1 * *",
+			after:    "2022-12-15T10:00:00Z",
+			expected: "2023-01-01T00:00:00Z",
+		},
+		"Should support day of week names": {
+			expr:     "30 9 * * MON-FRI",
+			after:    "2022-03-11T10:00:00Z", // Friday
+			expected: "2022-03-14T09:30:00Z",
+		},
+		"Should treat 7 as Sunday": {
+			expr:     "0 0 * * 7",
+			after:    "2022-03-08T10:00:00Z",
+			expected: "2022-03-13T00:00:00Z",
+		},
+		"Should match any day if both day fields are restricted": {
+			expr:     "0 0 20 * SUN",
+			after:    "2022-03-08T10:00:00Z",
+			expected: "2022-03-13T00:00:00Z",
+		},
+		"Should support month names": {
+			expr:     "0 0 29 feb *",
+			after:    "2022-03-08T10:00:00Z",
+			expected: "2024-02-29T00:00:00Z",
+		},
+		"Should support macros": {
+			expr:     "@hourly",
+			after:    "2022-03-08T10:00:00Z",
+			expected: "2022-03-08T11:00:00Z",
+		},
+		"Should return zero time if expression never matches": {
+			expr:     "0 0 30 2 *",
+			after:    "2022-03-08T10:00:00Z",
+			expected: "0001-01-01T00:00:00Z",
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			expr, err := schedule.ParseExpression(tc.expr)
+			require.NoError(t, err)
+
+			// when
+			next := expr.Next(parseTime(t, tc.after))
+
+			// then
+			assert.Equal(t, parseTime(t, tc.expected), next)
+		})
+	}
+}
+
+func TestParseExpressionFailures(t *testing.T) {
+	tests := map[string]struct {
+		expr   string
+		errMsg string
+	}{
+		"Should reject missing fields": {
+			expr:   "* * * *",
+			errMsg: `cron expression "* * * *" must have exactly 5 fields, got 4`,
+		},
+		"Should reject out of range value": {
+			expr:   "60 * * * *",
+			errMsg: `invalid minute field "60": value 60 is out of range [0, 59]`,
+		},
+		"Should reject inverted range": {
+			expr:   "* 10-5 * * *",
+			errMsg: `invalid hour field "10-5": range start 10 is greater than its end 5`,
+		},
+		"Should reject zero step": {
+			expr:   "*/0 * * * *",
+			errMsg: `invalid minute field "*/0": step must be a positive number`,
+		},
+		"Should reject unknown name": {
+			expr:   "* * * JANUARY *",
+			errMsg: `invalid month field "JANUARY": "JANUARY" is not a valid value`,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			_, err := schedule.ParseExpression(tc.expr)
+
+			// then
+			assert.EqualError(t, err, tc.errMsg)
+		})
+	}
+}
+
+func parseTime(t *testing.T, in string) time.Time {
+	t.Helper()
+
+	out, err := time.Parse(time.RFC3339, in)
+	require.NoError(t, err)
+	return out
+}
@@ -0,0 +1,57 @@
+package schedule
+
+import "fmt"
+
+// InvalidInputError is returned if a given input doesn't pass validation.
+type InvalidInputError struct {
+	reason string
+}
+
+// NewInvalidInputError returns a new InvalidInputError instance.
+func NewInvalidInputError(format string, args ...interface{}) *InvalidInputError {
+	return &InvalidInputError{reason: fmt.Sprintf(format, args...)}
+}
+
+// Error returns error message.
+func (e InvalidInputError) Error() string {
+	return e.reason
+}
+
+// InvalidInput implements behavior error interface.
+func (e InvalidInputError) InvalidInput() {}
+
+// NotFoundError is returned if ScheduledJob was not found.
+type NotFoundError struct {
+	name string
+}
+
+// NewNotFoundError returns a new NotFoundError instance.
+func NewNotFoundError(name string) *NotFoundError {
+	return &NotFoundError{name: name}
+}
+
+// Error returns error message.
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("ScheduledJob %q not found", e.name)
+}
+
+// NotFound implements behavior error interface.
+func (e NotFoundError) NotFound() {}
+
+// ConflictError is returned if ScheduledJob with a given name already exists.
+type ConflictError struct {
+	name string
+}
+
+// NewConflictError returns a new ConflictError instance.
+func NewConflictError(name string) *ConflictError {
+	return &ConflictError{name: name}
+}
+
+// Error returns error message.
+func (e ConflictError) Error() string {
+	return fmt.Sprintf("ScheduledJob %q already exists", e.name)
+}
+
+// Conflict implements behavior error interface.
+func (e ConflictError) Conflict() {}
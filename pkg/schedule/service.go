@@ -0,0 +1,427 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/mszostok/job-runner/internal/shutdown"
+	"github.com/mszostok/job-runner/pkg/job"
+)
+
+var _ shutdown.ShutdownableService = &Service{}
+
+// namespaceSeparator separates tenant and ScheduledJob name in namespaced names, the same as for Jobs.
+const namespaceSeparator = "/"
+
+// replaceGracePeriod represents a period of time given to the running Job to terminate gracefully
+// when it's replaced by a new one and its template doesn't specify TimeoutGracePeriod.
+const replaceGracePeriod = 10 * time.Second
+
+// ErrShuttingDown is returned when a new ScheduledJob is requested during Service shutdown.
+var ErrShuttingDown = errors.New("service is shutting down, cannot create new ScheduledJobs")
+
+// JobService provides functionality to manage Jobs created by ScheduledJobs.
+type JobService interface {
+	Run(context.Context, job.RunInput) (*job.RunOutput, error)
+	Get(context.Context, job.GetInput) (*job.GetOutput, error)
+	Stop(context.Context, job.StopInput) (*job.StopOutput, error)
+	Delete(context.Context, job.DeleteInput) (*job.DeleteOutput, error)
+}
+
+// Service provides functionality to create Jobs periodically according to cron expressions.
+type Service struct {
+	jobs JobService
+	// store persists ScheduledJobs if set. Otherwise, ScheduledJobs are stored only in memory.
+	store *fileStore
+
+	mu sync.Mutex
+	// schedules holds ScheduledJobs indexed by namespaced name, see scheduleKey.
+	schedules    map[string]*entry
+	shuttingDown bool
+	// firing tracks scheduled Jobs which are being started.
+	firing sync.WaitGroup
+}
+
+// entry represents a single ScheduledJob together with its timer.
+type entry struct {
+	def   ScheduledJob
+	expr  *Expression
+	next  time.Time
+	timer *time.Timer
+}
+
+// NewService returns a new Service instance. ScheduledJobs stored by previous instances are loaded and scheduled.
+func NewService(jobs JobService, opts ...ServiceOption) (*Service, error) {
+	svc := &Service{
+		jobs:      jobs,
+		schedules: map[string]*entry{},
+	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	if svc.store == nil {
+		return svc, nil
+	}
+
+	stored, err := svc.store.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	for _, def := range stored {
+		expr, err := ParseExpression(def.Cron)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while parsing stored ScheduledJob %q", def.Name)
+		}
+		e := &entry{def: def, expr: expr}
+		svc.schedules[scheduleKey(def.Tenant, def.Name)] = e
+		svc.scheduleNextLocked(e, time.Now())
+	}
+
+	return svc, nil
+}
+
+// Create creates a new ScheduledJob. The first Job is created at the next time matching the cron expression.
+func (s *Service) Create(_ context.Context, in CreateInput) (*CreateOutput, error) {
+	if in.Name == "" {
+		return nil, NewInvalidInputError("name cannot be empty")
+	}
+	if strings.Contains(in.Name, namespaceSeparator) {
+		return nil, NewInvalidInputError("name %q cannot contain %q", in.Name, namespaceSeparator)
+	}
+	if in.HistoryLimit < 0 {
+		return nil, NewInvalidInputError("history limit cannot be negative")
+	}
+	if err := in.Template.Validate(); err != nil {
+		return nil, errors.Wrap(err, "while validating Job template")
+	}
+
+	switch in.ConcurrencyPolicy {
+	case "":
+		in.ConcurrencyPolicy = AllowConcurrent
+	case AllowConcurrent, ForbidConcurrent, ReplaceConcurrent:
+	default:
+		return nil, NewInvalidInputError("unknown concurrency policy %q", in.ConcurrencyPolicy)
+	}
+	if in.HistoryLimit == 0 {
+		in.HistoryLimit = DefaultHistoryLimit
+	}
+
+	expr, err := ParseExpression(in.Cron)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if expr.Next(now).IsZero() {
+		return nil, NewInvalidInputError("cron expression %q never matches", in.Cron)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.shuttingDown {
+		return nil, ErrShuttingDown
+	}
+	key := scheduleKey(in.Tenant, in.Name)
+	if _, found := s.schedules[key]; found {
+		return nil, NewConflictError(in.Name)
+	}
+
+	e := &entry{
+		def: ScheduledJob{
+			Name:              in.Name,
+			Tenant:            in.Tenant,
+			Cron:              in.Cron,
+			Template:          in.Template,
+			ConcurrencyPolicy: in.ConcurrencyPolicy,
+			HistoryLimit:      in.HistoryLimit,
+			CreatedAt:         now,
+		},
+		expr: expr,
+	}
+	s.schedules[key] = e
+	if err := s.persistLocked(); err != nil {
+		delete(s.schedules, key)
+		return nil, errors.Wrap(err, "while storing ScheduledJob")
+	}
+	s.scheduleNextLocked(e, now)
+
+	return &CreateOutput{NextScheduleAt: e.next}, nil
+}
+
+// Get returns a given ScheduledJob.
+func (s *Service) Get(_ context.Context, in GetInput) (*GetOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, found := s.schedules[scheduleKey(in.Tenant, in.Name)]
+	if !found {
+		return nil, NewNotFoundError(in.Name)
+	}
+
+	out := e.output()
+	return &out, nil
+}
+
+// List returns ScheduledJobs that match given constraints.
+func (s *Service) List(_ context.Context, in ListInput) (*ListOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := &ListOutput{}
+	for _, e := range s.schedules {
+		if in.Tenant != "" && e.def.Tenant != in.Tenant {
+			continue
+		}
+		out.Schedules = append(out.Schedules, e.output())
+	}
+	sort.Slice(out.Schedules, func(i, j int) bool {
+		a, b := out.Schedules[i].Schedule, out.Schedules[j].Schedule
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Tenant < b.Tenant
+	})
+
+	return out, nil
+}
+
+// Delete deletes a given ScheduledJob, so no more Jobs are created. Already created Jobs are not affected.
+func (s *Service) Delete(_ context.Context, in DeleteInput) (*DeleteOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := scheduleKey(in.Tenant, in.Name)
+	e, found := s.schedules[key]
+	if !found {
+		return nil, NewNotFoundError(in.Name)
+	}
+
+	delete(s.schedules, key)
+	if err := s.persistLocked(); err != nil {
+		s.schedules[key] = e
+		return nil, errors.Wrap(err, "while deleting ScheduledJob")
+	}
+	if e.timer != nil {
+		e.timer.Stop()
+	}
+
+	return &DeleteOutput{}, nil
+}
+
+// Shutdown stops creating new Jobs. It waits until Jobs which are being started are started.
+// Jobs created by ScheduledJobs are not stopped, it's up to the Job service.
+func (s *Service) Shutdown() error {
+	s.mu.Lock()
+	s.shuttingDown = true
+	for _, e := range s.schedules {
+		if e.timer != nil {
+			e.timer.Stop()
+		}
+	}
+	s.mu.Unlock()
+
+	s.firing.Wait()
+	return nil
+}
+
+// JobName returns the name of Job created by a given ScheduledJob at a given time.
+// It's derived from the scheduled time, so it's unique within the tenant namespace as long as the ScheduledJob name is.
+func JobName(scheduleName string, scheduledAt time.Time) string {
+	return fmt.Sprintf("%s-%d", scheduleName, scheduledAt.Unix()/60)
+}
+
+// scheduleNextLocked sets timer for the next Job after a given time. Missed activation times are skipped.
+func (s *Service) scheduleNextLocked(e *entry, after time.Time) {
+	if e.timer != nil {
+		e.timer.Stop()
+	}
+	e.next = e.expr.Next(after)
+	if e.next.IsZero() {
+		return
+	}
+
+	key, scheduledAt := scheduleKey(e.def.Tenant, e.def.Name), e.next
+	e.timer = time.AfterFunc(time.Until(scheduledAt), func() {
+		s.mu.Lock()
+		if s.shuttingDown || s.schedules[key] != e {
+			s.mu.Unlock()
+			return
+		}
+		s.firing.Add(1)
+		s.mu.Unlock()
+
+		defer s.firing.Done()
+		s.fire(e, scheduledAt)
+	})
+}
+
+// fire creates a new Job according to the ScheduledJob's concurrency policy and schedules the next one.
+func (s *Service) fire(e *entry, scheduledAt time.Time) {
+	s.mu.Lock()
+	def := e.def
+	s.mu.Unlock()
+
+	ctx := context.Background()
+	active := s.activeJobs(ctx, def.Jobs)
+
+	var id string
+	runErr := s.applyConcurrencyPolicy(ctx, def, active)
+	if runErr == nil {
+		id, runErr = s.runJob(ctx, def, scheduledAt)
+	}
+
+	// Jobs dropped from history are deleted once the lock is released, as it may take a while.
+	var dropped []string
+	defer func() {
+		s.deleteJobs(ctx, dropped)
+	}()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.schedules[scheduleKey(def.Tenant, def.Name)] != e { // deleted in the meantime
+		return
+	}
+
+	e.def.LastScheduleAt = scheduledAt
+	e.def.LastError = ""
+	switch {
+	case errors.Is(runErr, errConcurrentJobRunning):
+		// Job was skipped on purpose, keep the history as it is.
+	case runErr != nil:
+		e.def.LastError = runErr.Error()
+	default:
+		e.def.Jobs, dropped = trimHistory(append(e.def.Jobs, id), e.def.HistoryLimit, active)
+	}
+	// The state is kept in memory, so failed write is retried on the next change.
+	_ = s.persistLocked()
+
+	if !s.shuttingDown {
+		s.scheduleNextLocked(e, time.Now())
+	}
+}
+
+// errConcurrentJobRunning is returned if a new Job is skipped as the previous one is still running.
+var errConcurrentJobRunning = errors.New("previous Job is still running")
+
+func (s *Service) applyConcurrencyPolicy(ctx context.Context, def ScheduledJob, active []string) error {
+	if len(active) == 0 {
+		return nil
+	}
+
+	switch def.ConcurrencyPolicy {
+	case ForbidConcurrent:
+		return errConcurrentJobRunning
+	case ReplaceConcurrent:
+		gracePeriod := def.Template.TimeoutGracePeriod
+		if gracePeriod == 0 {
+			gracePeriod = replaceGracePeriod
+		}
+		for _, id := range active {
+			_, err := s.jobs.Stop(ctx, job.StopInput{
+				ID:          id,
+				GracePeriod: gracePeriod,
+				StoppedBy:   def.Tenant,
+			})
+			if err != nil && !job.IsNotFoundError(err) {
+				return errors.Wrapf(err, "while stopping Job %q", id)
+			}
+		}
+	}
+	return nil
+}
+
+// runJob runs a new Job and returns its ID.
+func (s *Service) runJob(ctx context.Context, def ScheduledJob, scheduledAt time.Time) (string, error) {
+	out, err := s.jobs.Run(ctx, def.Template.RunInput(def.Tenant, JobName(def.Name, scheduledAt)))
+	if err != nil {
+		return "", errors.Wrap(err, "while running Job")
+	}
+	return out.ID, nil
+}
+
+// activeJobs returns IDs of Jobs which are still running.
+func (s *Service) activeJobs(ctx context.Context, ids []string) []string {
+	var out []string
+	for _, id := range ids {
+		got, err := s.jobs.Get(ctx, job.GetInput{ID: id})
+		if err != nil {
+			continue // e.g. Job was removed, so it's not running for sure
+		}
+		if !got.Status.IsFinished() {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// trimHistory drops the oldest finished Jobs, so at most limit Jobs are kept. Running Jobs are always kept.
+// It returns kept and dropped Jobs.
+func trimHistory(jobs []string, limit int, active []string) ([]string, []string) {
+	running := map[string]struct{}{}
+	for _, id := range active {
+		running[id] = struct{}{}
+	}
+
+	toDrop := len(jobs) - limit
+	var (
+		kept    = make([]string, 0, len(jobs))
+		dropped []string
+	)
+	for _, id := range jobs {
+		if _, isRunning := running[id]; toDrop > 0 && !isRunning {
+			toDrop--
+			dropped = append(dropped, id)
+			continue
+		}
+		kept = append(kept, id)
+	}
+	return kept, dropped
+}
+
+// deleteJobs deletes given finished Jobs together with their logs.
+func (s *Service) deleteJobs(ctx context.Context, ids []string) {
+	for _, id := range ids {
+		// Job may be already deleted by tenant or retention policy. Otherwise, it's left to the retention policy.
+		// TODO(simplification): handle error, e.g. log it (zap/logrus)
+		_, _ = s.jobs.Delete(ctx, job.DeleteInput{ID: id})
+	}
+}
+
+func (s *Service) persistLocked() error {
+	if s.store == nil {
+		return nil
+	}
+
+	all := make([]ScheduledJob, 0, len(s.schedules))
+	for _, e := range s.schedules {
+		all = append(all, e.def)
+	}
+	return s.store.Save(all)
+}
+
+// scheduleKey returns ScheduledJob name prefixed with its tenant, e.g. "Ricky/nightly".
+// ScheduledJob names are unique only within the tenant namespace.
+func scheduleKey(tenant, name string) string {
+	return tenant + namespaceSeparator + name
+}
+
+func (e *entry) output() GetOutput {
+	def := e.def
+	def.Jobs = append([]string(nil), e.def.Jobs...)
+	return GetOutput{
+		Schedule:       def,
+		NextScheduleAt: e.next,
+	}
+}
@@ -0,0 +1,11 @@
+package schedule
+
+// ServiceOption provides an option to configure Service instance.
+type ServiceOption func(cfg *Service)
+
+// WithStateDir persists ScheduledJobs in a given directory, so they survive Agent restarts.
+func WithStateDir(dir string) ServiceOption {
+	return func(cfg *Service) {
+		cfg.store = newFileStore(dir)
+	}
+}
@@ -0,0 +1,344 @@
+package schedule
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mszostok/job-runner/pkg/job"
+)
+
+func TestServiceFireConcurrencyPolicy(t *testing.T) {
+	tests := map[string]struct {
+		policy     ConcurrencyPolicy
+		expStarted []string
+		expStopped []string
+		expHistory []string
+		expRunning []string
+	}{
+		"Should start Job next to the running one": {
+			policy:     AllowConcurrent,
+			expStarted: []string{"nightly-1", "nightly-2"},
+			expHistory: []string{"nightly-1", "nightly-2"},
+			expRunning: []string{"nightly-1", "nightly-2"},
+		},
+		"Should skip Job if the previous one is running": {
+			policy:     ForbidConcurrent,
+			expStarted: []string{"nightly-1"},
+			expHistory: []string{"nightly-1"},
+			expRunning: []string{"nightly-1"},
+		},
+		"Should replace the running Job": {
+			policy:     ReplaceConcurrent,
+			expStarted: []string{"nightly-1", "nightly-2"},
+			expStopped: []string{"nightly-1"},
+			expHistory: []string{"nightly-1", "nightly-2"},
+			expRunning: []string{"nightly-2"},
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			jobs := newFakeJobService()
+			svc, err := NewService(jobs)
+			require.NoError(t, err)
+			defer svc.Shutdown()
+
+			_, err = svc.Create(context.Background(), CreateInput{
+				Tenant:            "Ricky",
+				Name:              "nightly",
+				Cron:              "@daily",
+				Template:          job.Template{Command: "make", Args: []string{"e2e-test"}},
+				ConcurrencyPolicy: tc.policy,
+			})
+			require.NoError(t, err)
+
+			// when
+			svc.fire(svc.schedules["Ricky/nightly"], time.Unix(60, 0))
+			svc.fire(svc.schedules["Ricky/nightly"], time.Unix(120, 0))
+
+			// then
+			got, err := svc.Get(context.Background(), GetInput{Tenant: "Ricky", Name: "nightly"})
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expStarted, jobs.started)
+			assert.Equal(t, tc.expStopped, jobs.stopped)
+			assert.Equal(t, tc.expHistory, got.Schedule.Jobs)
+			assert.Equal(t, tc.expRunning, jobs.runningNames())
+			assert.Equal(t, time.Unix(120, 0), got.Schedule.LastScheduleAt)
+			assert.Empty(t, got.Schedule.LastError)
+
+			for _, in := range jobs.runInputs {
+				assert.Equal(t, "Ricky", in.Tenant)
+				assert.Equal(t, "make", in.Command)
+				assert.Equal(t, []string{"e2e-test"}, in.Args)
+			}
+		})
+	}
+}
+
+func TestServiceFireHistoryLimit(t *testing.T) {
+	// given
+	jobs := newFakeJobService()
+	svc, err := NewService(jobs)
+	require.NoError(t, err)
+	defer svc.Shutdown()
+
+	_, err = svc.Create(context.Background(), CreateInput{
+		Tenant:       "Ricky",
+		Name:         "nightly",
+		Cron:         "@daily",
+		Template:     job.Template{Command: "make"},
+		HistoryLimit: 2,
+	})
+	require.NoError(t, err)
+
+	// when
+	for i := int64(1); i <= 4; i++ {
+		svc.fire(svc.schedules["Ricky/nightly"], time.Unix(i*60, 0))
+		if i != 1 {
+			jobs.finish(JobName("nightly", time.Unix(i*60, 0)))
+		}
+	}
+
+	// then
+	got, err := svc.Get(context.Background(), GetInput{Tenant: "Ricky", Name: "nightly"})
+	require.NoError(t, err)
+
+	// the first Job is still running, so it's kept instead of more recent finished ones
+	assert.Equal(t, []string{"nightly-1", "nightly-4"}, got.Schedule.Jobs)
+	assert.Equal(t, []string{"nightly-2", "nightly-3"}, jobs.deleted)
+}
+
+func TestServiceNamespaces(t *testing.T) {
+	// given
+	svc, err := NewService(newFakeJobService())
+	require.NoError(t, err)
+	defer svc.Shutdown()
+
+	ctx := context.Background()
+	create := func(tenant string) error {
+		_, err := svc.Create(ctx, CreateInput{Tenant: tenant, Name: "nightly", Cron: "@daily", Template: job.Template{Command: "make"}})
+		return err
+	}
+
+	// when
+	rickyErr := create("Ricky")
+	mortyErr := create("Morty")
+	conflictErr := create("Ricky")
+
+	// then
+	assert.NoError(t, rickyErr)
+	assert.NoError(t, mortyErr)
+	assert.EqualError(t, conflictErr, `ScheduledJob "nightly" already exists`)
+
+	got, err := svc.Get(ctx, GetInput{Tenant: "Morty", Name: "nightly"})
+	require.NoError(t, err)
+	assert.Equal(t, "Morty", got.Schedule.Tenant)
+
+	_, err = svc.Delete(ctx, DeleteInput{Tenant: "Summer", Name: "nightly"})
+	assert.EqualError(t, err, `ScheduledJob "nightly" not found`)
+	_, err = svc.Delete(ctx, DeleteInput{Tenant: "Ricky", Name: "nightly"})
+	require.NoError(t, err)
+
+	out, err := svc.List(ctx, ListInput{})
+	require.NoError(t, err)
+	require.Len(t, out.Schedules, 1)
+	assert.Equal(t, "Morty", out.Schedules[0].Schedule.Tenant)
+}
+
+func TestServiceFireRecordsError(t *testing.T) {
+	// given
+	jobs := newFakeJobService()
+	jobs.runErr = job.ErrShuttingDown
+	svc, err := NewService(jobs)
+	require.NoError(t, err)
+	defer svc.Shutdown()
+
+	_, err = svc.Create(context.Background(), CreateInput{
+		Tenant:   "Ricky",
+		Name:     "nightly",
+		Cron:     "@daily",
+		Template: job.Template{Command: "make"},
+	})
+	require.NoError(t, err)
+
+	// when
+	svc.fire(svc.schedules["Ricky/nightly"], time.Unix(60, 0))
+
+	// then
+	got, err := svc.Get(context.Background(), GetInput{Tenant: "Ricky", Name: "nightly"})
+	require.NoError(t, err)
+
+	assert.Empty(t, got.Schedule.Jobs)
+	assert.Equal(t, "while running Job: service is shutting down, cannot run new Jobs", got.Schedule.LastError)
+}
+
+func TestServiceCreateFailures(t *testing.T) {
+	tests := map[string]struct {
+		in     CreateInput
+		errMsg string
+	}{
+		"Should reject invalid cron expression": {
+			in:     CreateInput{Name: "nightly", Cron: "* * *", Template: job.Template{Command: "make"}},
+			errMsg: `cron expression "* * *" must have exactly 5 fields, got 3`,
+		},
+		"Should reject expression which never matches": {
+			in:     CreateInput{Name: "nightly", Cron: "0 0 31 4 *", Template: job.Template{Command: "make"}},
+			errMsg: `cron expression "0 0 31 4 *" never matches`,
+		},
+		"Should reject unknown concurrency policy": {
+			in:     CreateInput{Name: "nightly", Cron: "@daily", Template: job.Template{Command: "make"}, ConcurrencyPolicy: "QUEUE"},
+			errMsg: `unknown concurrency policy "QUEUE"`,
+		},
+		"Should reject missing command": {
+			in:     CreateInput{Name: "nightly", Cron: "@daily"},
+			errMsg: "while validating Job template: command cannot be empty",
+		},
+		"Should reject namespaced name": {
+			in:     CreateInput{Name: "Morty/nightly", Cron: "@daily", Template: job.Template{Command: "make"}},
+			errMsg: `name "Morty/nightly" cannot contain "/"`,
+		},
+		"Should reject negative history limit": {
+			in:     CreateInput{Name: "nightly", Cron: "@daily", Template: job.Template{Command: "make"}, HistoryLimit: -1},
+			errMsg: "history limit cannot be negative",
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			svc, err := NewService(newFakeJobService())
+			require.NoError(t, err)
+			defer svc.Shutdown()
+
+			// when
+			_, err = svc.Create(context.Background(), tc.in)
+
+			// then
+			require.Error(t, err)
+			assert.EqualError(t, err, tc.errMsg)
+			assert.True(t, job.IsInvalidInputError(err))
+		})
+	}
+}
+
+func TestServiceStateDir(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	svc, err := NewService(newFakeJobService(), WithStateDir(dir))
+	require.NoError(t, err)
+
+	_, err = svc.Create(context.Background(), CreateInput{Tenant: "Ricky", Name: "nightly", Cron: "@daily", Template: job.Template{Command: "make"}})
+	require.NoError(t, err)
+	_, err = svc.Create(context.Background(), CreateInput{Tenant: "Ricky", Name: "weekly", Cron: "@weekly", Template: job.Template{Command: "make"}})
+	require.NoError(t, err)
+	_, err = svc.Delete(context.Background(), DeleteInput{Tenant: "Ricky", Name: "weekly"})
+	require.NoError(t, err)
+	require.NoError(t, svc.Shutdown())
+
+	// when
+	restored, err := NewService(newFakeJobService(), WithStateDir(dir))
+	require.NoError(t, err)
+	defer restored.Shutdown()
+
+	// then
+	out, err := restored.List(context.Background(), ListInput{})
+	require.NoError(t, err)
+	require.Len(t, out.Schedules, 1)
+
+	got := out.Schedules[0]
+	assert.Equal(t, "nightly", got.Schedule.Name)
+	assert.Equal(t, "Ricky", got.Schedule.Tenant)
+	assert.Equal(t, AllowConcurrent, got.Schedule.ConcurrencyPolicy)
+	assert.Equal(t, DefaultHistoryLimit, got.Schedule.HistoryLimit)
+	assert.False(t, got.NextScheduleAt.IsZero())
+
+	_, err = restored.Get(context.Background(), GetInput{Tenant: "Ricky", Name: "weekly"})
+	assert.True(t, job.IsNotFoundError(err))
+}
+
+// fakeJobService runs no processes, Jobs are running until finish is called.
+type fakeJobService struct {
+	mu        sync.Mutex
+	runErr    error
+	running   map[string]bool
+	started   []string
+	stopped   []string
+	deleted   []string
+	runInputs []job.RunInput
+}
+
+func newFakeJobService() *fakeJobService {
+	return &fakeJobService{running: map[string]bool{}}
+}
+
+func (f *fakeJobService) Run(_ context.Context, in job.RunInput) (*job.RunOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.runErr != nil {
+		return nil, f.runErr
+	}
+	f.running[in.Name] = true
+	f.started = append(f.started, in.Name)
+	f.runInputs = append(f.runInputs, in)
+	return &job.RunOutput{ID: in.Name, Name: in.Name}, nil // IDs are the same as names, so assertions are simpler
+}
+
+func (f *fakeJobService) Get(_ context.Context, in job.GetInput) (*job.GetOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	running, found := f.running[in.ID]
+	if !found {
+		return nil, NewNotFoundError(in.ID)
+	}
+	if running {
+		return &job.GetOutput{Status: job.Running}, nil
+	}
+	return &job.GetOutput{Status: job.Succeeded}, nil
+}
+
+func (f *fakeJobService) Stop(_ context.Context, in job.StopInput) (*job.StopOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.running[in.ID] = false
+	f.stopped = append(f.stopped, in.ID)
+	return &job.StopOutput{Status: job.Terminated}, nil
+}
+
+func (f *fakeJobService) Delete(_ context.Context, in job.DeleteInput) (*job.DeleteOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, found := f.running[in.ID]; !found {
+		return nil, NewNotFoundError(in.ID)
+	}
+	delete(f.running, in.ID)
+	f.deleted = append(f.deleted, in.ID)
+	return &job.DeleteOutput{}, nil
+}
+
+func (f *fakeJobService) finish(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.running[name] = false
+}
+
+func (f *fakeJobService) runningNames() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var out []string
+	for _, name := range f.started {
+		if f.running[name] {
+			out = append(out, name)
+		}
+	}
+	return out
+}
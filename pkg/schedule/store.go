@@ -0,0 +1,76 @@
+package schedule
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	storeFileName = "schedules.json"
+	storeFilePerm = 0600
+)
+
+// fileStore persists all ScheduledJobs in a single JSON file.
+// ScheduledJobs change rarely, so the whole file is rewritten on each change.
+type fileStore struct {
+	path string
+}
+
+func newFileStore(dir string) *fileStore {
+	return &fileStore{path: filepath.Join(dir, storeFileName)}
+}
+
+// Load returns all stored ScheduledJobs. Returns empty list if nothing was stored yet.
+func (s *fileStore) Load() ([]ScheduledJob, error) {
+	raw, err := os.ReadFile(filepath.Clean(s.path))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "while reading schedules")
+	}
+
+	var out []ScheduledJob
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, errors.Wrap(err, "while decoding schedules")
+	}
+	return out, nil
+}
+
+// Save replaces stored ScheduledJobs with a given ones. It's atomic, the old file is replaced only if the new one was fully written.
+func (s *fileStore) Save(schedules []ScheduledJob) (err error) {
+	raw, err := json.Marshal(schedules)
+	if err != nil {
+		return errors.Wrap(err, "while encoding schedules")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), storeFileName+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err = tmp.Write(raw); err != nil {
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		return err
+	}
+	if err = tmp.Chmod(storeFilePerm); err != nil {
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
@@ -0,0 +1,100 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/mszostok/job-runner/pkg/job"
+)
+
+// DefaultHistoryLimit represents a default number of the most recent Jobs kept in ScheduledJob history.
+const DefaultHistoryLimit = 3
+
+// ConcurrencyPolicy specifies how to treat concurrent executions of Jobs created by the same ScheduledJob.
+type ConcurrencyPolicy string
+
+const (
+	// AllowConcurrent allows Jobs to run concurrently.
+	AllowConcurrent ConcurrencyPolicy = "ALLOW"
+	// ForbidConcurrent skips a new Job if the previous one is still running.
+	ForbidConcurrent ConcurrencyPolicy = "FORBID"
+	// ReplaceConcurrent stops the running Job and replaces it with a new one.
+	ReplaceConcurrent ConcurrencyPolicy = "REPLACE"
+)
+
+// ScheduledJob represents Jobs created periodically according to the cron expression.
+type ScheduledJob struct {
+	// Name specifies ScheduledJob name. It's unique within the tenant namespace.
+	// Created Jobs are named "<name>-<scheduled time in Unix minutes>".
+	Name string
+	// Tenant specifies the tenant that created ScheduledJob. All created Jobs belong to the same tenant.
+	Tenant string
+	// Cron specifies the schedule in cron format, e.g. "*/5 * * * *". It's evaluated in the Agent's local time.
+	Cron string
+	// Template describes created Jobs.
+	Template job.Template
+	// ConcurrencyPolicy specifies how to treat a new Job if the previous one is still running.
+	ConcurrencyPolicy ConcurrencyPolicy
+	// HistoryLimit specifies the number of the most recent Jobs kept in history.
+	// Older finished Jobs are deleted together with their logs.
+	HistoryLimit int
+	// CreatedAt specifies when ScheduledJob was created.
+	CreatedAt time.Time
+	// LastScheduleAt specifies when the last Job was scheduled. Zero if no Job was scheduled yet.
+	LastScheduleAt time.Time
+	// LastError describes why the last Job couldn't be created. Empty if it was created successfully.
+	LastError string
+	// Jobs holds IDs of the most recent Jobs, the oldest first.
+	Jobs []string
+}
+
+type CreateInput struct {
+	// Tenant specifies the tenant of a given ScheduledJob.
+	Tenant string
+	// Name specifies ScheduledJob name.
+	Name string
+	// Cron specifies the schedule in cron format.
+	Cron string
+	// Template describes created Jobs.
+	Template job.Template
+	// ConcurrencyPolicy specifies how to treat a new Job if the previous one is still running. Empty means AllowConcurrent.
+	ConcurrencyPolicy ConcurrencyPolicy
+	// HistoryLimit specifies the number of the most recent Jobs kept in history. Zero means DefaultHistoryLimit.
+	HistoryLimit int
+}
+
+type CreateOutput struct {
+	// NextScheduleAt specifies when the first Job will be scheduled.
+	NextScheduleAt time.Time
+}
+
+type GetInput struct {
+	// Tenant specifies the tenant namespace of a given ScheduledJob.
+	Tenant string
+	// Name specifies ScheduledJob name.
+	Name string
+}
+
+type GetOutput struct {
+	Schedule ScheduledJob
+	// NextScheduleAt specifies when the next Job will be scheduled.
+	NextScheduleAt time.Time
+}
+
+type ListInput struct {
+	// Tenant returns only ScheduledJobs created by a given tenant. If empty, ScheduledJobs of all tenants are returned.
+	Tenant string
+}
+
+type ListOutput struct {
+	// Schedules holds ScheduledJobs sorted by name and tenant.
+	Schedules []GetOutput
+}
+
+type DeleteInput struct {
+	// Tenant specifies the tenant namespace of a given ScheduledJob.
+	Tenant string
+	// Name specifies ScheduledJob name.
+	Name string
+}
+
+type DeleteOutput struct{}
@@ -0,0 +1,43 @@
+package security
+
+import (
+	"github.com/cockroachdb/errors"
+	"golang.org/x/sys/unix"
+)
+
+// All functions below change attributes of the current thread only, so the caller needs to lock the goroutine
+// to its OS thread, and execute the command from it.
+
+// PrepareCredentialChange drops capabilities which are not kept by the profile from the bounding set.
+// It must be called before the process user is changed, as it requires CAP_SETPCAP.
+func (p Profile) PrepareCredentialChange() error {
+	if p.Capabilities == nil {
+		return nil
+	}
+	return p.Capabilities.dropBoundingSet()
+}
+
+// Apply applies the profile restrictions. It must be called after the process user is changed,
+// right before the command is executed. Seccomp filter is loaded as the last one, so it doesn't block
+// applying other restrictions.
+func (p Profile) Apply() error {
+	if p.Capabilities != nil {
+		if err := p.Capabilities.set(); err != nil {
+			return err
+		}
+	}
+	if p.NoNewPrivileges {
+		if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+			return errors.Wrap(err, "while setting no_new_privs")
+		}
+	}
+	if p.Landlock != nil {
+		if err := p.Landlock.restrict(); err != nil {
+			return err
+		}
+	}
+	if p.Seccomp != nil {
+		return p.Seccomp.load()
+	}
+	return nil
+}
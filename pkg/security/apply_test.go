@@ -0,0 +1,47 @@
+package security_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/mszostok/job-runner/pkg/security"
+)
+
+func TestProfileApplySeccomp(t *testing.T) {
+	// given
+	profile := security.Profile{
+		NoNewPrivileges: true,
+		Seccomp: &security.SeccompFilter{
+			DefaultAction: security.SeccompAllow,
+			Syscalls: []security.SeccompRule{
+				{Names: []string{"uname"}, Action: security.SeccompDeny},
+			},
+		},
+	}
+	require.NoError(t, profile.Validate())
+
+	var applyErr, unameErr error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// the thread is restricted, so it's not unlocked, and the runtime terminates it once goroutine exits
+		runtime.LockOSThread()
+
+		// when
+		applyErr = profile.Apply()
+		var uts unix.Utsname
+		unameErr = unix.Uname(&uts)
+	}()
+	<-done
+
+	// then
+	require.NoError(t, applyErr)
+	assert.ErrorIs(t, unameErr, unix.EPERM)
+
+	var uts unix.Utsname
+	assert.NoError(t, unix.Uname(&uts), "other threads should not be restricted")
+}
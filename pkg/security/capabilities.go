@@ -0,0 +1,124 @@
+package security
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/sys/unix"
+)
+
+// capabilities maps capability names to their values.
+var capabilities = map[string]uint{
+	"CAP_CHOWN":              unix.CAP_CHOWN,
+	"CAP_DAC_OVERRIDE":       unix.CAP_DAC_OVERRIDE,
+	"CAP_DAC_READ_SEARCH":    unix.CAP_DAC_READ_SEARCH,
+	"CAP_FOWNER":             unix.CAP_FOWNER,
+	"CAP_FSETID":             unix.CAP_FSETID,
+	"CAP_KILL":               unix.CAP_KILL,
+	"CAP_SETGID":             unix.CAP_SETGID,
+	"CAP_SETUID":             unix.CAP_SETUID,
+	"CAP_SETPCAP":            unix.CAP_SETPCAP,
+	"CAP_LINUX_IMMUTABLE":    unix.CAP_LINUX_IMMUTABLE,
+	"CAP_NET_BIND_SERVICE":   unix.CAP_NET_BIND_SERVICE,
+	"CAP_NET_BROADCAST":      unix.CAP_NET_BROADCAST,
+	"CAP_NET_ADMIN":          unix.CAP_NET_ADMIN,
+	"CAP_NET_RAW":            unix.CAP_NET_RAW,
+	"CAP_IPC_LOCK":           unix.CAP_IPC_LOCK,
+	"CAP_IPC_OWNER":          unix.CAP_IPC_OWNER,
+	"CAP_SYS_MODULE":         unix.CAP_SYS_MODULE,
+	"CAP_SYS_RAWIO":          unix.CAP_SYS_RAWIO,
+	"CAP_SYS_CHROOT":         unix.CAP_SYS_CHROOT,
+	"CAP_SYS_PTRACE":         unix.CAP_SYS_PTRACE,
+	"CAP_SYS_PACCT":          unix.CAP_SYS_PACCT,
+	"CAP_SYS_ADMIN":          unix.CAP_SYS_ADMIN,
+	"CAP_SYS_BOOT":           unix.CAP_SYS_BOOT,
+	"CAP_SYS_NICE":           unix.CAP_SYS_NICE,
+	"CAP_SYS_RESOURCE":       unix.CAP_SYS_RESOURCE,
+	"CAP_SYS_TIME":           unix.CAP_SYS_TIME,
+	"CAP_SYS_TTY_CONFIG":     unix.CAP_SYS_TTY_CONFIG,
+	"CAP_MKNOD":              unix.CAP_MKNOD,
+	"CAP_LEASE":              unix.CAP_LEASE,
+	"CAP_AUDIT_WRITE":        unix.CAP_AUDIT_WRITE,
+	"CAP_AUDIT_CONTROL":      unix.CAP_AUDIT_CONTROL,
+	"CAP_SETFCAP":            unix.CAP_SETFCAP,
+	"CAP_MAC_OVERRIDE":       unix.CAP_MAC_OVERRIDE,
+	"CAP_MAC_ADMIN":          unix.CAP_MAC_ADMIN,
+	"CAP_SYSLOG":             unix.CAP_SYSLOG,
+	"CAP_WAKE_ALARM":         unix.CAP_WAKE_ALARM,
+	"CAP_BLOCK_SUSPEND":      unix.CAP_BLOCK_SUSPEND,
+	"CAP_AUDIT_READ":         unix.CAP_AUDIT_READ,
+	"CAP_PERFMON":            unix.CAP_PERFMON,
+	"CAP_BPF":                unix.CAP_BPF,
+	"CAP_CHECKPOINT_RESTORE": unix.CAP_CHECKPOINT_RESTORE,
+}
+
+// capLastCapPath holds the highest capability supported by the running kernel.
+const capLastCapPath = "/proc/sys/kernel/cap_last_cap"
+
+// keptCapabilities returns values of the kept capabilities.
+func (c Capabilities) keptCapabilities() map[uint]struct{} {
+	out := map[uint]struct{}{}
+	for _, name := range c.Keep {
+		if value, found := capabilityValue(name); found {
+			out[value] = struct{}{}
+		}
+	}
+	return out
+}
+
+// dropBoundingSet removes not kept capabilities from the bounding set of the current thread, and keeps permitted
+// capabilities when the user is changed, so the kept ones can be raised afterwards. It requires CAP_SETPCAP.
+func (c Capabilities) dropBoundingSet() error {
+	keep := c.keptCapabilities()
+	for value := uint(0); value <= lastCapability(); value++ {
+		if _, found := keep[value]; found {
+			continue
+		}
+		if err := unix.Prctl(unix.PR_CAPBSET_DROP, uintptr(value), 0, 0, 0); err != nil && !errors.Is(err, unix.EINVAL) {
+			return errors.Wrapf(err, "while dropping capability %d from bounding set", value)
+		}
+	}
+	return unix.Prctl(unix.PR_SET_KEEPCAPS, 1, 0, 0, 0)
+}
+
+// set sets effective, permitted and inheritable capabilities of the current thread to the kept ones. They are also
+// raised as ambient capabilities, so the executed command keeps them even if it doesn't run as root.
+func (c Capabilities) set() error {
+	keep := c.keptCapabilities()
+
+	hdr := unix.CapUserHeader{Version: unix.LINUX_CAPABILITY_VERSION_3}
+	var data [2]unix.CapUserData // version 3 uses two 32-bit sets
+	for value := range keep {
+		data[value/32].Effective |= 1 << (value % 32)
+		data[value/32].Permitted |= 1 << (value % 32)
+		data[value/32].Inheritable |= 1 << (value % 32)
+	}
+	if err := unix.Capset(&hdr, &data[0]); err != nil {
+		return errors.Wrap(err, "while setting capabilities")
+	}
+
+	if err := unix.Prctl(unix.PR_CAP_AMBIENT, unix.PR_CAP_AMBIENT_CLEAR_ALL, 0, 0, 0); err != nil {
+		return errors.Wrap(err, "while clearing ambient capabilities")
+	}
+	for value := range keep {
+		if err := unix.Prctl(unix.PR_CAP_AMBIENT, unix.PR_CAP_AMBIENT_RAISE, uintptr(value), 0, 0); err != nil {
+			return errors.Wrapf(err, "while raising ambient capability %d", value)
+		}
+	}
+	return nil
+}
+
+// lastCapability returns the highest capability supported by the running kernel.
+func lastCapability() uint {
+	raw, err := os.ReadFile(capLastCapPath)
+	if err != nil {
+		return unix.CAP_LAST_CAP
+	}
+	value, err := strconv.ParseUint(strings.TrimSpace(string(raw)), 10, 32)
+	if err != nil {
+		return unix.CAP_LAST_CAP
+	}
+	return uint(value)
+}
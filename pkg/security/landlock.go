@@ -0,0 +1,75 @@
+package security
+
+import (
+	"unsafe"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// landlockAccessFS holds all filesystem access rights of the first Landlock ABI. They are denied unless allowed
+	// by a rule. Rights added by later ABIs, e.g. renaming between directories, are not restricted.
+	landlockAccessFS = unix.LANDLOCK_ACCESS_FS_EXECUTE | unix.LANDLOCK_ACCESS_FS_WRITE_FILE | unix.LANDLOCK_ACCESS_FS_READ_FILE |
+		unix.LANDLOCK_ACCESS_FS_READ_DIR | unix.LANDLOCK_ACCESS_FS_REMOVE_DIR | unix.LANDLOCK_ACCESS_FS_REMOVE_FILE |
+		unix.LANDLOCK_ACCESS_FS_MAKE_CHAR | unix.LANDLOCK_ACCESS_FS_MAKE_DIR | unix.LANDLOCK_ACCESS_FS_MAKE_REG |
+		unix.LANDLOCK_ACCESS_FS_MAKE_SOCK | unix.LANDLOCK_ACCESS_FS_MAKE_FIFO | unix.LANDLOCK_ACCESS_FS_MAKE_BLOCK |
+		unix.LANDLOCK_ACCESS_FS_MAKE_SYM
+	landlockAccessReadOnly = unix.LANDLOCK_ACCESS_FS_EXECUTE | unix.LANDLOCK_ACCESS_FS_READ_FILE | unix.LANDLOCK_ACCESS_FS_READ_DIR
+	// landlockAccessFile holds rights which apply to files. Rules for files cannot contain directory rights.
+	landlockAccessFile = unix.LANDLOCK_ACCESS_FS_EXECUTE | unix.LANDLOCK_ACCESS_FS_WRITE_FILE | unix.LANDLOCK_ACCESS_FS_READ_FILE
+)
+
+// restrict restricts filesystem access of the current thread to the ruleset paths. It's inherited by the executed
+// command. Paths are resolved in the current root filesystem.
+func (r LandlockRuleset) restrict() error {
+	if _, _, errno := unix.Syscall(unix.SYS_LANDLOCK_CREATE_RULESET, 0, 0, unix.LANDLOCK_CREATE_RULESET_VERSION); errno != 0 {
+		return errors.Wrap(errno, "Landlock is not supported by the kernel")
+	}
+
+	attr := unix.LandlockRulesetAttr{Access_fs: landlockAccessFS}
+	fd, _, errno := unix.Syscall(unix.SYS_LANDLOCK_CREATE_RULESET, uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr), 0)
+	if errno != 0 {
+		return errors.Wrap(errno, "while creating Landlock ruleset")
+	}
+	defer unix.Close(int(fd))
+
+	for _, path := range r.ReadOnly {
+		if err := addLandlockRule(int(fd), path, landlockAccessReadOnly); err != nil {
+			return errors.Wrapf(err, "while adding Landlock rule for %q", path)
+		}
+	}
+	for _, path := range r.ReadWrite {
+		if err := addLandlockRule(int(fd), path, landlockAccessFS); err != nil {
+			return errors.Wrapf(err, "while adding Landlock rule for %q", path)
+		}
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_LANDLOCK_RESTRICT_SELF, fd, 0, 0); errno != 0 {
+		return errors.Wrap(errno, "while enforcing Landlock ruleset")
+	}
+	return nil
+}
+
+func addLandlockRule(rulesetFD int, path string, access uint64) error {
+	fd, err := unix.Open(path, unix.O_PATH|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+
+	var stat unix.Stat_t
+	if err := unix.Fstat(fd, &stat); err != nil {
+		return err
+	}
+	if stat.Mode&unix.S_IFMT != unix.S_IFDIR {
+		access &= landlockAccessFile
+	}
+
+	attr := unix.LandlockPathBeneathAttr{Allowed_access: access, Parent_fd: int32(fd)}
+	_, _, errno := unix.Syscall6(unix.SYS_LANDLOCK_ADD_RULE, uintptr(rulesetFD), unix.LANDLOCK_RULE_PATH_BENEATH, uintptr(unsafe.Pointer(&attr)), 0, 0, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package security
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Profiles holds named security profiles processes can be hardened with.
+type Profiles struct {
+	// Default specifies the profile applied if process doesn't request any. If empty, such processes run unrestricted.
+	Default string `json:"default"`
+	// Profiles holds profiles indexed by name.
+	Profiles map[string]Profile `json:"profiles"`
+}
+
+// Profile specifies restrictions applied to the process right before its command is executed.
+type Profile struct {
+	// Capabilities specifies capabilities kept by the process. If not set, capabilities are not changed.
+	Capabilities *Capabilities `json:"capabilities,omitempty"`
+	// NoNewPrivileges sets the no_new_privs flag, so executed binaries cannot gain privileges, e.g. via setuid bit.
+	NoNewPrivileges bool `json:"noNewPrivileges,omitempty"`
+	// Seccomp specifies syscalls filter. It requires NoNewPrivileges.
+	Seccomp *SeccompFilter `json:"seccomp,omitempty"`
+	// Landlock specifies filesystem paths accessible by the process. It requires NoNewPrivileges.
+	Landlock *LandlockRuleset `json:"landlock,omitempty"`
+}
+
+// Capabilities specifies capabilities kept by the process.
+type Capabilities struct {
+	// Keep holds names of kept capabilities, e.g. "CAP_NET_BIND_SERVICE". All other capabilities are dropped,
+	// also from the bounding set, so they cannot be regained. Empty list drops all capabilities.
+	Keep []string `json:"keep"`
+}
+
+// SeccompAction specifies what happens when the process calls a given syscall.
+type SeccompAction string
+
+const (
+	// SeccompAllow allows the syscall.
+	SeccompAllow SeccompAction = "ALLOW"
+	// SeccompDeny fails the syscall with EPERM.
+	SeccompDeny SeccompAction = "DENY"
+	// SeccompKill kills the process.
+	SeccompKill SeccompAction = "KILL"
+)
+
+// SeccompFilter specifies actions for syscalls.
+type SeccompFilter struct {
+	// DefaultAction is applied to syscalls not listed in Syscalls.
+	DefaultAction SeccompAction `json:"defaultAction"`
+	// Syscalls holds rules checked in order. If a syscall is listed by multiple rules, the first one is applied.
+	Syscalls []SeccompRule `json:"syscalls"`
+}
+
+// SeccompRule specifies the action for given syscalls.
+type SeccompRule struct {
+	// Names holds syscall names, e.g. "ptrace".
+	Names []string `json:"names"`
+	// Action is applied when the process calls one of the syscalls.
+	Action SeccompAction `json:"action"`
+}
+
+// LandlockRuleset specifies filesystem paths accessible by the process. Any other path is inaccessible.
+type LandlockRuleset struct {
+	// ReadOnly holds absolute paths which content can be read and executed.
+	ReadOnly []string `json:"readOnly"`
+	// ReadWrite holds absolute paths with full access.
+	ReadWrite []string `json:"readWrite"`
+}
+
+// Get returns a given profile.
+func (p *Profiles) Get(name string) (Profile, bool) {
+	profile, found := p.Profiles[name]
+	return profile, found
+}
+
+// Validate returns error if any profile is not valid, or the default one is not defined.
+func (p *Profiles) Validate() error {
+	if p.Default != "" {
+		if _, found := p.Profiles[p.Default]; !found {
+			return errors.Newf("default profile %q is not defined", p.Default)
+		}
+	}
+	for name, profile := range p.Profiles {
+		if err := profile.Validate(); err != nil {
+			return errors.Wrapf(err, "profile %q", name)
+		}
+	}
+	return nil
+}
+
+// Validate returns error if profile cannot be applied.
+func (p Profile) Validate() error {
+	if p.Capabilities != nil {
+		for _, name := range p.Capabilities.Keep {
+			if _, found := capabilityValue(name); !found {
+				return errors.Newf("unknown capability %q", name)
+			}
+		}
+	}
+	if (p.Seccomp != nil || p.Landlock != nil) && !p.NoNewPrivileges {
+		return errors.New("seccomp and landlock can be used only together with noNewPrivileges")
+	}
+	if p.Seccomp != nil {
+		if err := p.Seccomp.Validate(); err != nil {
+			return errors.Wrap(err, "seccomp")
+		}
+	}
+	if p.Landlock != nil {
+		for _, path := range append(p.Landlock.ReadOnly, p.Landlock.ReadWrite...) {
+			if !filepath.IsAbs(path) {
+				return errors.Newf("landlock path %q must be an absolute path", path)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate returns error if actions are unknown, or syscalls are not available on the current architecture.
+func (f SeccompFilter) Validate() error {
+	if err := f.DefaultAction.Validate(); err != nil {
+		return err
+	}
+	if len(syscalls) == 0 {
+		return errors.New("filters are not supported on this architecture")
+	}
+	for _, rule := range f.Syscalls {
+		if err := rule.Action.Validate(); err != nil {
+			return err
+		}
+		for _, name := range rule.Names {
+			if _, found := syscalls[name]; !found {
+				return errors.Newf("unknown syscall %q", name)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate returns error if action is unknown.
+func (a SeccompAction) Validate() error {
+	switch a {
+	case SeccompAllow, SeccompDeny, SeccompKill:
+		return nil
+	}
+	return errors.Newf("unknown action %q, allowed values: %s, %s, %s", a, SeccompAllow, SeccompDeny, SeccompKill)
+}
+
+// capabilityValue returns value of a given capability. The "CAP_" prefix is optional, and name is case-insensitive.
+func capabilityValue(name string) (uint, bool) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "CAP_") {
+		name = "CAP_" + name
+	}
+	value, found := capabilities[name]
+	return value, found
+}
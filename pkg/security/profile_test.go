@@ -0,0 +1,107 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/mszostok/job-runner/pkg/security"
+)
+
+func TestProfilesValidate(t *testing.T) {
+	tests := map[string]struct {
+		profiles string
+		errMsg   string
+	}{
+		"Should accept valid profiles": {
+			profiles: `
+default: restricted
+profiles:
+  restricted:
+    capabilities:
+      keep: [CAP_NET_BIND_SERVICE, chown]
+    noNewPrivileges: true
+    seccomp:
+      defaultAction: ALLOW
+      syscalls:
+        - names: [ptrace, mount]
+          action: DENY
+    landlock:
+      readOnly: [/usr, /etc]
+      readWrite: [/tmp]
+  unrestricted: {}`,
+		},
+		"Should reject unknown default profile": {
+			profiles: `
+default: restricted
+profiles:
+  unrestricted: {}`,
+			errMsg: `default profile "restricted" is not defined`,
+		},
+		"Should reject unknown capability": {
+			profiles: `
+profiles:
+  restricted:
+    capabilities:
+      keep: [CAP_EVERYTHING]`,
+			errMsg: `profile "restricted": unknown capability "CAP_EVERYTHING"`,
+		},
+		"Should reject seccomp without no_new_privs": {
+			profiles: `
+profiles:
+  restricted:
+    seccomp:
+      defaultAction: ALLOW`,
+			errMsg: `profile "restricted": seccomp and landlock can be used only together with noNewPrivileges`,
+		},
+		"Should reject unknown syscall": {
+			profiles: `
+profiles:
+  restricted:
+    noNewPrivileges: true
+    seccomp:
+      defaultAction: ALLOW
+      syscalls:
+        - names: [teleport]
+          action: DENY`,
+			errMsg: `profile "restricted": seccomp: unknown syscall "teleport"`,
+		},
+		"Should reject unknown action": {
+			profiles: `
+profiles:
+  restricted:
+    noNewPrivileges: true
+    seccomp:
+      defaultAction: LOG`,
+			errMsg: `profile "restricted": seccomp: unknown action "LOG", allowed values: ALLOW, DENY, KILL`,
+		},
+		"Should reject relative Landlock path": {
+			profiles: `
+profiles:
+  restricted:
+    noNewPrivileges: true
+    landlock:
+      readOnly: [usr]`,
+			errMsg: `profile "restricted": landlock path "usr" must be an absolute path`,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			var profiles security.Profiles
+			require.NoError(t, yaml.UnmarshalStrict([]byte(tc.profiles), &profiles))
+
+			// when
+			err := profiles.Validate()
+
+			// then
+			if tc.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.errMsg)
+		})
+	}
+}
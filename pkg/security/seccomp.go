@@ -0,0 +1,72 @@
+package security
+
+import (
+	"unsafe"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/sys/unix"
+)
+
+// Values returned by seccomp filters, and offsets of the seccomp_data fields, as defined in linux/seccomp.h.
+const (
+	seccompRetKillProcess = 0x80000000
+	seccompRetErrno       = 0x00050000
+	seccompRetAllow       = 0x7fff0000
+
+	seccompDataNrOffset   = 0
+	seccompDataArchOffset = 4
+)
+
+// program returns BPF program which implements the filter. Syscalls made with a different architecture than
+// the current one, e.g. 32-bit syscalls on amd64, would be matched against wrong numbers, so they kill the process.
+func (f SeccompFilter) program() []unix.SockFilter {
+	prog := []unix.SockFilter{
+		bpfStmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataArchOffset),
+		bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, auditArch, 1, 0),
+		bpfStmt(unix.BPF_RET|unix.BPF_K, seccompRetKillProcess),
+		bpfStmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataNrOffset),
+	}
+	prog = append(prog, archChecks()...)
+
+	for _, rule := range f.Syscalls {
+		for _, name := range rule.Names {
+			prog = append(prog,
+				bpfJump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, syscalls[name], 0, 1),
+				bpfStmt(unix.BPF_RET|unix.BPF_K, rule.Action.ret()),
+			)
+		}
+	}
+	return append(prog, bpfStmt(unix.BPF_RET|unix.BPF_K, f.DefaultAction.ret()))
+}
+
+// load loads the filter for the current thread. It's inherited by the executed command.
+func (f SeccompFilter) load() error {
+	prog := f.program()
+	fprog := unix.SockFprog{
+		Len:    uint16(len(prog)),
+		Filter: &prog[0],
+	}
+	if err := unix.Prctl(unix.PR_SET_SECCOMP, unix.SECCOMP_MODE_FILTER, uintptr(unsafe.Pointer(&fprog)), 0, 0); err != nil {
+		return errors.Wrap(err, "while loading seccomp filter")
+	}
+	return nil
+}
+
+func (a SeccompAction) ret() uint32 {
+	switch a {
+	case SeccompAllow:
+		return seccompRetAllow
+	case SeccompDeny:
+		return seccompRetErrno | uint32(unix.EPERM)
+	default:
+		return seccompRetKillProcess
+	}
+}
+
+func bpfStmt(code uint16, k uint32) unix.SockFilter {
+	return unix.SockFilter{Code: code, K: k}
+}
+
+func bpfJump(code uint16, k uint32, jt, jf uint8) unix.SockFilter {
+	return unix.SockFilter{Code: code, Jt: jt, Jf: jf, K: k}
+}
@@ -0,0 +1,19 @@
+package security
+
+import "golang.org/x/sys/unix"
+
+const (
+	// auditArch is AUDIT_ARCH_X86_64 from linux/audit.h.
+	auditArch = 0xc000003e
+	// x32SyscallBit marks syscalls made with the x32 ABI, which share the architecture with amd64.
+	x32SyscallBit = 0x40000000
+)
+
+// archChecks returns BPF instructions which kill the process making x32 ABI syscalls, so they cannot bypass
+// the filter. The syscall number is already loaded.
+func archChecks() []unix.SockFilter {
+	return []unix.SockFilter{
+		bpfJump(unix.BPF_JMP|unix.BPF_JGE|unix.BPF_K, x32SyscallBit, 0, 1),
+		bpfStmt(unix.BPF_RET|unix.BPF_K, seccompRetKillProcess),
+	}
+}
@@ -0,0 +1,11 @@
+package security
+
+import "golang.org/x/sys/unix"
+
+// auditArch is AUDIT_ARCH_AARCH64 from linux/audit.h.
+const auditArch = 0xc00000b7
+
+// archChecks returns no instructions, as arm64 has a single syscall ABI.
+func archChecks() []unix.SockFilter {
+	return nil
+}
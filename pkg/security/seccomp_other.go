@@ -0,0 +1,16 @@
+//go:build !amd64 && !arm64
+// +build !amd64,!arm64
+
+package security
+
+import "golang.org/x/sys/unix"
+
+// auditArch is unknown, seccomp filters are not supported on this architecture.
+const auditArch = 0
+
+// syscalls is empty, so seccomp filters are rejected by validation.
+var syscalls = map[string]uint32{}
+
+func archChecks() []unix.SockFilter {
+	return nil
+}
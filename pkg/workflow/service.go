@@ -0,0 +1,349 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/mszostok/job-runner/internal/shutdown"
+	"github.com/mszostok/job-runner/pkg/job"
+)
+
+var _ shutdown.ShutdownableService = &Service{}
+
+// ErrShuttingDown is returned when a new Workflow is submitted during Service shutdown.
+var ErrShuttingDown = errors.New("service is shutting down, cannot submit new Workflows")
+
+// namespaceSeparator separates tenant and Workflow name in namespaced names, the same as for Jobs.
+const namespaceSeparator = "/"
+
+// JobService provides functionality to run Workflow's steps.
+type JobService interface {
+	Run(context.Context, job.RunInput) (*job.RunOutput, error)
+	Wait(context.Context, job.WaitInput) (*job.WaitOutput, error)
+	Get(context.Context, job.GetInput) (*job.GetOutput, error)
+}
+
+// Service provides functionality to execute Workflows, so each step is started only when all its dependencies succeeded.
+type Service struct {
+	jobs JobService
+
+	// TODO(simplification): Workflows are kept only in memory. Steps' Jobs don't survive Agent restart anyway,
+	// but the Workflows history could be persisted in the same way as Jobs.
+	mu sync.RWMutex
+	// workflows holds the last run of each Workflow, indexed by namespaced name, see workflowKey.
+	workflows map[string]*Workflow
+
+	// ctx is canceled on shutdown, so goroutines waiting for steps are released.
+	ctx          context.Context
+	cancel       context.CancelFunc
+	running      sync.WaitGroup
+	shuttingDown bool
+}
+
+// NewService returns a new Service instance.
+func NewService(jobs JobService) *Service {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Service{
+		jobs:      jobs,
+		workflows: map[string]*Workflow{},
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// Submit validates a given Workflow and starts all steps without dependencies. It doesn't wait for steps to finish.
+// If the tenant already submitted a Workflow with the same name and it's finished, it's replaced by a new run.
+func (s *Service) Submit(_ context.Context, in SubmitInput) (*SubmitOutput, error) {
+	if err := validate(in); err != nil {
+		return nil, err
+	}
+
+	wf := &Workflow{
+		Name:      in.Name,
+		Tenant:    in.Tenant,
+		Status:    Running,
+		CreatedAt: time.Now(),
+	}
+	for _, step := range in.Steps {
+		wf.Steps = append(wf.Steps, StepState{
+			Name:      step.Name,
+			DependsOn: step.DependsOn,
+			Status:    StepPending,
+		})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.shuttingDown {
+		return nil, ErrShuttingDown
+	}
+	key := workflowKey(in.Tenant, in.Name)
+	if prev, found := s.workflows[key]; found && prev.Status == Running {
+		return nil, NewConflictError(in.Name)
+	}
+	s.workflows[key] = wf
+
+	done := map[string]chan struct{}{}
+	for _, step := range in.Steps {
+		done[step.Name] = make(chan struct{})
+	}
+
+	s.running.Add(len(in.Steps) + 1)
+	for idx, step := range in.Steps {
+		deps := make([]<-chan struct{}, 0, len(step.DependsOn))
+		for _, dep := range step.DependsOn {
+			deps = append(deps, done[dep])
+		}
+		go s.runStep(wf, idx, step, deps, done[step.Name])
+	}
+	go s.finishWhenDone(wf, done)
+
+	return &SubmitOutput{}, nil
+}
+
+// Get returns the last run of a given Workflow together with its steps' states.
+// States of steps which are not finished yet are refreshed from their Jobs, so e.g. a queued Job is reported as such.
+func (s *Service) Get(ctx context.Context, in GetInput) (*GetOutput, error) {
+	s.mu.RLock()
+	wf, found := s.workflows[workflowKey(in.Tenant, in.Name)]
+	if !found {
+		s.mu.RUnlock()
+		return nil, NewNotFoundError(in.Name)
+	}
+	out := wf.copy()
+	s.mu.RUnlock()
+
+	for idx := range out.Steps {
+		step := &out.Steps[idx]
+		if step.JobID == "" || step.Status.IsFinished() {
+			continue
+		}
+		got, err := s.jobs.Get(ctx, job.GetInput{ID: step.JobID})
+		if err != nil { // the Job is awaited anyway, report the last known state
+			continue
+		}
+		step.Status = StepStatus(got.Status)
+		step.StartedAt = got.StartedAt
+	}
+	return &GetOutput{Workflow: out}, nil
+}
+
+// Shutdown stops starting new steps. Already running steps' Jobs are not stopped, it's up to the Job service.
+func (s *Service) Shutdown() error {
+	s.mu.Lock()
+	s.shuttingDown = true
+	s.mu.Unlock()
+
+	s.cancel()
+	s.running.Wait()
+	return nil
+}
+
+// JobNamePrefix returns the prefix of the name of Job created for a given Workflow step.
+// A random suffix is appended to it, so each Workflow run creates new Jobs.
+func JobNamePrefix(workflowName, stepName string) string {
+	return fmt.Sprintf("%s-%s-", workflowName, stepName)
+}
+
+// workflowKey returns the key under which a given Workflow is stored. Names are unique within the tenant namespace.
+func workflowKey(tenant, name string) string {
+	return tenant + namespaceSeparator + name
+}
+
+// runStep waits for all dependencies, and starts the step's Job only if all of them succeeded.
+// The step is finished when its Job finished.
+func (s *Service) runStep(wf *Workflow, idx int, step Step, deps []<-chan struct{}, done chan struct{}) {
+	defer s.running.Done()
+	defer close(done)
+
+	for _, dep := range deps {
+		select {
+		case <-dep:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+
+	if !s.dependenciesSucceeded(wf, step.DependsOn) {
+		s.updateStep(wf, idx, func(state *StepState) {
+			state.Status = StepSkipped
+			state.FinishedAt = time.Now()
+		})
+		return
+	}
+
+	runIn := step.Template.RunInput(wf.Tenant, "")
+	runIn.GenerateName = JobNamePrefix(wf.Name, step.Name)
+	run, err := s.jobs.Run(s.ctx, runIn)
+	if err != nil {
+		s.updateStep(wf, idx, func(state *StepState) {
+			state.Status = StepFailed
+			state.Error = errors.Wrap(err, "while running Job").Error()
+			state.FinishedAt = time.Now()
+		})
+		return
+	}
+	// the Job may wait for concurrency limits, its actual state is read from the Job service on Get
+	s.updateStep(wf, idx, func(state *StepState) {
+		state.JobName = run.Name
+		state.JobID = run.ID
+		state.Status = StepQueued
+	})
+
+	out, err := s.jobs.Wait(s.ctx, job.WaitInput{ID: run.ID})
+	if err != nil {
+		if s.ctx.Err() != nil { // shutting down, the Job's status is still tracked by the Job service
+			return
+		}
+		s.updateStep(wf, idx, func(state *StepState) {
+			state.Status = StepLost
+			state.Error = errors.Wrap(err, "while waiting for Job").Error()
+			state.FinishedAt = time.Now()
+		})
+		return
+	}
+
+	s.updateStep(wf, idx, func(state *StepState) {
+		state.Status = StepStatus(out.Status)
+		state.ExitCode = out.ExitCode
+		state.FinishedAt = time.Now()
+	})
+}
+
+// finishWhenDone sets the final Workflow status once all steps are finished.
+func (s *Service) finishWhenDone(wf *Workflow, done map[string]chan struct{}) {
+	defer s.running.Done()
+
+	for _, ch := range done {
+		<-ch
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := Succeeded
+	for _, step := range wf.Steps {
+		if !step.Status.IsFinished() { // interrupted by shutdown
+			return
+		}
+		if step.Status != StepSucceeded {
+			status = Failed
+		}
+	}
+	wf.Status = status
+	wf.FinishedAt = time.Now()
+}
+
+func (s *Service) dependenciesSucceeded(wf *Workflow, deps []string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	succeeded := map[string]bool{}
+	for _, step := range wf.Steps {
+		succeeded[step.Name] = step.Status == StepSucceeded
+	}
+	for _, dep := range deps {
+		if !succeeded[dep] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Service) updateStep(wf *Workflow, idx int, update func(state *StepState)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	update(&wf.Steps[idx])
+}
+
+// validate returns error if Workflow has invalid steps, or steps' dependencies don't form a DAG.
+func validate(in SubmitInput) error {
+	if in.Name == "" {
+		return NewInvalidInputError("name cannot be empty")
+	}
+	if strings.Contains(in.Name, namespaceSeparator) {
+		return NewInvalidInputError("name %q cannot contain %q", in.Name, namespaceSeparator)
+	}
+	if len(in.Steps) == 0 {
+		return NewInvalidInputError("Workflow must have at least one step")
+	}
+
+	steps := map[string]Step{}
+	for _, step := range in.Steps {
+		if step.Name == "" {
+			return NewInvalidInputError("step name cannot be empty")
+		}
+		if _, found := steps[step.Name]; found {
+			return NewInvalidInputError("step %q is defined more than once", step.Name)
+		}
+		if err := step.Template.Validate(); err != nil {
+			return errors.Wrapf(err, "while validating step %q", step.Name)
+		}
+		steps[step.Name] = step
+	}
+
+	for _, step := range in.Steps {
+		for _, dep := range step.DependsOn {
+			if _, found := steps[dep]; !found {
+				return NewInvalidInputError("step %q depends on unknown step %q", step.Name, dep)
+			}
+		}
+	}
+
+	return checkAcyclic(in.Steps)
+}
+
+// checkAcyclic uses Kahn's algorithm. If not all steps can be sorted topologically, there is a cycle.
+func checkAcyclic(steps []Step) error {
+	pendingDeps := map[string]int{}
+	dependents := map[string][]string{}
+	for _, step := range steps {
+		pendingDeps[step.Name] = len(step.DependsOn)
+		for _, dep := range step.DependsOn {
+			dependents[dep] = append(dependents[dep], step.Name)
+		}
+	}
+
+	var ready []string
+	for _, step := range steps {
+		if pendingDeps[step.Name] == 0 {
+			ready = append(ready, step.Name)
+		}
+	}
+
+	sorted := 0
+	for len(ready) > 0 {
+		name := ready[0]
+		ready = ready[1:]
+		sorted++
+		for _, dependent := range dependents[name] {
+			pendingDeps[dependent]--
+			if pendingDeps[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if sorted != len(steps) {
+		var cyclic []string
+		for _, step := range steps {
+			if pendingDeps[step.Name] > 0 {
+				cyclic = append(cyclic, step.Name)
+			}
+		}
+		return NewInvalidInputError("steps dependencies must not form a cycle, steps in cycle or depending on it: %v", cyclic)
+	}
+	return nil
+}
+
+func (w *Workflow) copy() Workflow {
+	out := *w
+	out.Steps = append([]StepState(nil), w.Steps...)
+	return out
+}
@@ -0,0 +1,374 @@
+package workflow_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mszostok/job-runner/pkg/job"
+	"github.com/mszostok/job-runner/pkg/workflow"
+)
+
+func TestServiceSubmit(t *testing.T) {
+	tests := map[string]struct {
+		// results holds final statuses of steps' Jobs, indexed by step name
+		results   map[string]job.Status
+		expStatus workflow.Status
+		expSteps  map[string]workflow.StepStatus
+	}{
+		"Should run all steps in dependencies order": {
+			results: map[string]job.Status{
+				"fetch":   job.Succeeded,
+				"build":   job.Succeeded,
+				"test":    job.Succeeded,
+				"publish": job.Succeeded,
+			},
+			expStatus: workflow.Succeeded,
+			expSteps: map[string]workflow.StepStatus{
+				"fetch":   workflow.StepSucceeded,
+				"build":   workflow.StepSucceeded,
+				"test":    workflow.StepSucceeded,
+				"publish": workflow.StepSucceeded,
+			},
+		},
+		"Should skip downstream steps on failure": {
+			results: map[string]job.Status{
+				"fetch": job.Succeeded,
+				"build": job.Failed,
+			},
+			expStatus: workflow.Failed,
+			expSteps: map[string]workflow.StepStatus{
+				"fetch":   workflow.StepSucceeded,
+				"build":   workflow.StepFailed,
+				"test":    workflow.StepSkipped,
+				"publish": workflow.StepSkipped,
+			},
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			jobs := newFakeJobService(tc.results)
+			svc := workflow.NewService(jobs)
+			defer svc.Shutdown()
+
+			// when
+			_, err := svc.Submit(context.Background(), workflow.SubmitInput{
+				Tenant: "Ricky",
+				Name:   "release",
+				Steps: []workflow.Step{
+					{Name: "publish", DependsOn: []string{"test"}, Template: job.Template{Command: "make", Args: []string{"publish"}}},
+					{Name: "test", DependsOn: []string{"build"}, Template: job.Template{Command: "make", Args: []string{"test"}}},
+					{Name: "build", DependsOn: []string{"fetch"}, Template: job.Template{Command: "make", Args: []string{"build"}}},
+					{Name: "fetch", Template: job.Template{Command: "git", Args: []string{"pull"}}},
+				},
+			})
+			require.NoError(t, err)
+
+			// then
+			var got workflow.Workflow
+			require.Eventually(t, func() bool {
+				out, err := svc.Get(context.Background(), workflow.GetInput{Tenant: "Ricky", Name: "release"})
+				require.NoError(t, err)
+				got = out.Workflow
+				return got.Status != workflow.Running
+			}, 5*time.Second, 10*time.Millisecond)
+
+			assert.Equal(t, tc.expStatus, got.Status)
+			assert.False(t, got.FinishedAt.IsZero())
+			for _, step := range got.Steps {
+				assert.Equal(t, tc.expSteps[step.Name], step.Status, step.Name)
+				if step.Status != workflow.StepSkipped {
+					assert.Equal(t, "release-"+step.Name+"-1", step.JobName)
+					assert.Equal(t, "id-"+step.JobName, step.JobID)
+				}
+			}
+
+			// Jobs were started only after their dependencies finished
+			expOrder := []string{"release-fetch-", "release-build-", "release-test-", "release-publish-"}
+			assert.Equal(t, expOrder[:len(tc.results)], jobs.startedPrefixes())
+			for _, in := range jobs.runInputs() {
+				assert.Equal(t, "Ricky", in.Tenant)
+				assert.Empty(t, in.Name)
+			}
+		})
+	}
+}
+
+func TestServiceSubmitReportsQueuedStep(t *testing.T) {
+	// given
+	jobs := newFakeJobService(map[string]job.Status{"fetch": job.Succeeded})
+	jobs.status = job.Queued
+	jobs.finish = make(chan struct{})
+	svc := workflow.NewService(jobs)
+	defer svc.Shutdown()
+
+	getStep := func() workflow.StepState {
+		out, err := svc.Get(context.Background(), workflow.GetInput{Tenant: "Ricky", Name: "release"})
+		require.NoError(t, err)
+		return out.Workflow.Steps[0]
+	}
+
+	// when
+	_, err := svc.Submit(context.Background(), workflow.SubmitInput{
+		Tenant: "Ricky",
+		Name:   "release",
+		Steps:  []workflow.Step{{Name: "fetch", Template: job.Template{Command: "git"}}},
+	})
+	require.NoError(t, err)
+
+	// then
+	require.Eventually(t, func() bool {
+		return getStep().JobID != ""
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, workflow.StepQueued, getStep().Status)
+
+	jobs.setStatus(job.Running)
+	assert.Equal(t, workflow.StepRunning, getStep().Status)
+
+	close(jobs.finish)
+	require.Eventually(t, func() bool {
+		return getStep().Status == workflow.StepSucceeded
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestServiceSubmitNamespaces(t *testing.T) {
+	// given
+	jobs := newFakeJobService(map[string]job.Status{"fetch": job.Succeeded})
+	jobs.finish = make(chan struct{})
+	svc := workflow.NewService(jobs)
+	defer svc.Shutdown()
+
+	submit := func(tenant string) error {
+		_, err := svc.Submit(context.Background(), workflow.SubmitInput{
+			Tenant: tenant,
+			Name:   "release",
+			Steps:  []workflow.Step{{Name: "fetch", Template: job.Template{Command: "git"}}},
+		})
+		return err
+	}
+	waitFinished := func(tenant string) workflow.Workflow {
+		var got workflow.Workflow
+		require.Eventually(t, func() bool {
+			out, err := svc.Get(context.Background(), workflow.GetInput{Tenant: tenant, Name: "release"})
+			require.NoError(t, err)
+			got = out.Workflow
+			return got.Status != workflow.Running
+		}, 5*time.Second, 10*time.Millisecond)
+		return got
+	}
+
+	// when
+	rickyErr := submit("Ricky")
+	mortyErr := submit("Morty")
+	conflictErr := submit("Ricky")
+
+	// then
+	assert.NoError(t, rickyErr)
+	assert.NoError(t, mortyErr)
+	assert.EqualError(t, conflictErr, `Workflow "release" already exists`)
+
+	_, err := svc.Get(context.Background(), workflow.GetInput{Tenant: "Summer", Name: "release"})
+	assert.EqualError(t, err, `Workflow "release" not found`)
+
+	// when
+	close(jobs.finish)
+	first := waitFinished("Ricky")
+	rerunErr := submit("Ricky")
+
+	// then
+	require.NoError(t, rerunErr)
+	second := waitFinished("Ricky")
+	assert.Equal(t, workflow.Succeeded, second.Status)
+	assert.NotEqual(t, first.Steps[0].JobName, second.Steps[0].JobName)
+}
+
+func TestServiceSubmitRecordsRunError(t *testing.T) {
+	// given
+	jobs := newFakeJobService(nil)
+	jobs.runErr = job.ErrShuttingDown
+	svc := workflow.NewService(jobs)
+	defer svc.Shutdown()
+
+	// when
+	_, err := svc.Submit(context.Background(), workflow.SubmitInput{
+		Tenant: "Ricky",
+		Name:   "release",
+		Steps:  []workflow.Step{{Name: "fetch", Template: job.Template{Command: "git"}}},
+	})
+	require.NoError(t, err)
+
+	// then
+	var got workflow.Workflow
+	require.Eventually(t, func() bool {
+		out, err := svc.Get(context.Background(), workflow.GetInput{Tenant: "Ricky", Name: "release"})
+		require.NoError(t, err)
+		got = out.Workflow
+		return got.Status != workflow.Running
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, workflow.Failed, got.Status)
+	assert.Equal(t, workflow.StepFailed, got.Steps[0].Status)
+	assert.Equal(t, "while running Job: service is shutting down, cannot run new Jobs", got.Steps[0].Error)
+}
+
+func TestServiceSubmitFailures(t *testing.T) {
+	tpl := job.Template{Command: "make"}
+	tests := map[string]struct {
+		name   string
+		steps  []workflow.Step
+		errMsg string
+	}{
+		"Should reject namespaced name": {
+			name:   "Morty/release",
+			steps:  []workflow.Step{{Name: "build", Template: tpl}},
+			errMsg: `name "Morty/release" cannot contain "/"`,
+		},
+		"Should reject Workflow without steps": {
+			errMsg: "Workflow must have at least one step",
+		},
+		"Should reject duplicated step": {
+			steps:  []workflow.Step{{Name: "build", Template: tpl}, {Name: "build", Template: tpl}},
+			errMsg: `step "build" is defined more than once`,
+		},
+		"Should reject unknown dependency": {
+			steps:  []workflow.Step{{Name: "build", DependsOn: []string{"fetch"}, Template: tpl}},
+			errMsg: `step "build" depends on unknown step "fetch"`,
+		},
+		"Should reject cycle": {
+			steps: []workflow.Step{
+				{Name: "fetch", Template: tpl},
+				{Name: "build", DependsOn: []string{"fetch", "test"}, Template: tpl},
+				{Name: "test", DependsOn: []string{"build"}, Template: tpl},
+				{Name: "publish", DependsOn: []string{"test"}, Template: tpl},
+			},
+			errMsg: "steps dependencies must not form a cycle, steps in cycle or depending on it: [build test publish]",
+		},
+		"Should reject invalid template": {
+			steps:  []workflow.Step{{Name: "build"}},
+			errMsg: `while validating step "build": command cannot be empty`,
+		},
+	}
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			// given
+			svc := workflow.NewService(newFakeJobService(nil))
+			defer svc.Shutdown()
+
+			name := "release"
+			if tc.name != "" {
+				name = tc.name
+			}
+
+			// when
+			_, err := svc.Submit(context.Background(), workflow.SubmitInput{
+				Tenant: "Ricky",
+				Name:   name,
+				Steps:  tc.steps,
+			})
+
+			// then
+			require.Error(t, err)
+			assert.EqualError(t, err, tc.errMsg)
+			assert.True(t, job.IsInvalidInputError(err))
+		})
+	}
+}
+
+// fakeJobService finishes each Job with a pre-defined status.
+type fakeJobService struct {
+	mu      sync.Mutex
+	runErr  error
+	results map[string]job.Status
+	started []job.RunInput
+	// steps holds step names indexed by Job ID
+	steps map[string]string
+	// status is reported by Get until the Job is finished
+	status job.Status
+	// finish blocks Wait until closed, if set
+	finish chan struct{}
+}
+
+func newFakeJobService(results map[string]job.Status) *fakeJobService {
+	return &fakeJobService{results: results, steps: map[string]string{}, status: job.Running}
+}
+
+func (f *fakeJobService) Run(_ context.Context, in job.RunInput) (*job.RunOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.runErr != nil {
+		return nil, f.runErr
+	}
+	f.started = append(f.started, in)
+	// the suffix counts Jobs started with the same prefix, so it's deterministic and unique
+	runs := 0
+	for _, started := range f.started {
+		if started.GenerateName == in.GenerateName {
+			runs++
+		}
+	}
+	name := fmt.Sprintf("%s%d", in.GenerateName, runs)
+	id := "id-" + name
+	f.steps[id] = strings.TrimSuffix(strings.TrimPrefix(in.GenerateName, "release-"), "-")
+	return &job.RunOutput{ID: id, Name: name}, nil
+}
+
+func (f *fakeJobService) Wait(ctx context.Context, in job.WaitInput) (*job.WaitOutput, error) {
+	if f.finish != nil {
+		select {
+		case <-f.finish:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	status := f.results[f.steps[in.ID]]
+	out := &job.WaitOutput{Status: status}
+	if status == job.Failed {
+		out.ExitCode = 1
+	}
+	return out, nil
+}
+
+func (f *fakeJobService) Get(_ context.Context, in job.GetInput) (*job.GetOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, found := f.steps[in.ID]; !found {
+		return nil, fmt.Errorf("Job %q not found", in.ID)
+	}
+	return &job.GetOutput{ID: in.ID, Status: f.status}, nil
+}
+
+func (f *fakeJobService) setStatus(status job.Status) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.status = status
+}
+
+func (f *fakeJobService) startedPrefixes() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var out []string
+	for _, in := range f.started {
+		out = append(out, in.GenerateName)
+	}
+	return out
+}
+
+func (f *fakeJobService) runInputs() []job.RunInput {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]job.RunInput(nil), f.started...)
+}
@@ -0,0 +1,114 @@
+package workflow
+
+import (
+	"time"
+
+	"github.com/mszostok/job-runner/pkg/job"
+)
+
+// Status specifies human-readable Workflow status.
+type Status string
+
+const (
+	// Running indicates that some steps are still pending or running.
+	Running Status = "RUNNING"
+	// Succeeded indicates that all steps succeeded.
+	Succeeded Status = "SUCCEEDED"
+	// Failed indicates that at least one step didn't succeed, so its downstream steps were skipped.
+	Failed Status = "FAILED"
+)
+
+// StepStatus specifies human-readable Workflow step status.
+type StepStatus string
+
+const (
+	// StepPending indicates that the step waits for its dependencies.
+	StepPending StepStatus = "PENDING"
+	// StepQueued indicates that the step's Job was created, but it waits until concurrency limits allow to start it.
+	StepQueued StepStatus = StepStatus(job.Queued)
+	// StepSkipped indicates that the step was not started as some of its dependencies didn't succeed.
+	StepSkipped StepStatus = "SKIPPED"
+	// StepFailed indicates that the step's Job failed, or it couldn't be started at all.
+	StepFailed StepStatus = StepStatus(job.Failed)
+	// Other statuses reflect the step's Job status.
+	StepRunning    StepStatus = StepStatus(job.Running)
+	StepSucceeded  StepStatus = StepStatus(job.Succeeded)
+	StepTerminated StepStatus = StepStatus(job.Terminated)
+	StepLost       StepStatus = StepStatus(job.Lost)
+)
+
+// IsFinished returns true if the step won't change its status anymore.
+func (s StepStatus) IsFinished() bool {
+	return s != StepPending && s != StepQueued && s != StepRunning
+}
+
+// Step describes a single Workflow's Job.
+type Step struct {
+	// Name specifies step name. It's unique within Workflow.
+	Name string
+	// DependsOn holds names of steps which must succeed before this step is started.
+	DependsOn []string
+	// Template describes the step's Job.
+	Template job.Template
+}
+
+// StepState holds the current state of a given step.
+type StepState struct {
+	// Name specifies step name.
+	Name string
+	// DependsOn holds names of steps which must succeed before this step is started.
+	DependsOn []string
+	// JobName specifies the name of the step's Job, "<workflow name>-<step name>-<random suffix>".
+	// Empty if the Job wasn't started yet.
+	JobName string
+	// JobID specifies the ID of the step's Job. Empty if the Job wasn't started yet.
+	JobID string
+	// Status of a given step.
+	Status StepStatus
+	// ExitCode of the step's Job. Should be ignored until the step is finished.
+	ExitCode int
+	// Error describes why the step's Job couldn't be started or awaited.
+	Error string
+	// StartedAt specifies when the step's Job process was started. Zero if it wasn't started yet.
+	StartedAt time.Time
+	// FinishedAt specifies when the step was finished. Zero if it isn't finished yet.
+	FinishedAt time.Time
+}
+
+// Workflow represents a DAG of Jobs executed by Agent.
+type Workflow struct {
+	// Name specifies Workflow name.
+	Name string
+	// Tenant specifies the tenant that submitted Workflow. All steps' Jobs belong to the same tenant.
+	Tenant string
+	// Status of a given Workflow.
+	Status Status
+	// CreatedAt specifies when Workflow was submitted.
+	CreatedAt time.Time
+	// FinishedAt specifies when all steps finished. Zero if Workflow is still running.
+	FinishedAt time.Time
+	// Steps holds steps' states in the submitted order.
+	Steps []StepState
+}
+
+type SubmitInput struct {
+	// Tenant specifies the tenant of a given Workflow.
+	Tenant string
+	// Name specifies Workflow name. It's unique within the tenant namespace among running Workflows.
+	Name string
+	// Steps holds Workflow's steps. Together with their dependencies, they must form a DAG.
+	Steps []Step
+}
+
+type SubmitOutput struct{}
+
+type GetInput struct {
+	// Tenant specifies the tenant namespace of a given Workflow.
+	Tenant string
+	// Name specifies Workflow name.
+	Name string
+}
+
+type GetOutput struct {
+	Workflow Workflow
+}